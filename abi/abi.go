@@ -0,0 +1,216 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/crypto"
+)
+
+//---------------------------------------------------------------
+// json abis, as output by solc
+
+type Argument struct {
+	Name    string
+	Type    Type
+	Indexed bool // for events
+}
+
+type argumentJSON struct {
+	Name       string         `json:"name"`
+	Type       string         `json:"type"`
+	Components []argumentJSON `json:"components"`
+	Indexed    bool           `json:"indexed"`
+}
+
+func (a *Argument) UnmarshalJSON(b []byte) error {
+	var aj argumentJSON
+	if err := json.Unmarshal(b, &aj); err != nil {
+		return err
+	}
+	return a.fromJSON(aj)
+}
+
+func (a *Argument) fromJSON(aj argumentJSON) (err error) {
+	components := make([]Argument, len(aj.Components))
+	for i, c := range aj.Components {
+		if err := components[i].fromJSON(c); err != nil {
+			return err
+		}
+	}
+	a.Name, a.Indexed = aj.Name, aj.Indexed
+	if a.Type, err = ParseType(aj.Type, components); err != nil {
+		return fmt.Errorf("argument %s: %v", aj.Name, err)
+	}
+	return nil
+}
+
+// A function, constructor, error or event
+type Method struct {
+	Type            string     `json:"type"`
+	Name            string     `json:"name"`
+	Inputs          []Argument `json:"inputs"`
+	Outputs         []Argument `json:"outputs"`
+	StateMutability string     `json:"stateMutability"`
+	Constant        bool       `json:"constant"` // older solc
+}
+
+// eg. transfer(address,uint256)
+func (m *Method) Signature() string {
+	return m.Name + typesString(m.Inputs)
+}
+
+// The first 4 bytes of the keccak256 hash of the signature
+func (m *Method) Selector() []byte {
+	return crypto.Keccak256([]byte(m.Signature()))[:4]
+}
+
+func typesString(args []Argument) string {
+	types := make([]string, len(args))
+	for i, a := range args {
+		types[i] = a.Type.String()
+	}
+	return "(" + strings.Join(types, ",") + ")"
+}
+
+type ABI struct {
+	Constructor *Method
+	Functions   []*Method
+	Errors      []*Method
+	Events      []*Method
+}
+
+func LoadABI(path string) (*ABI, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	abi, err := ParseABI(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return abi, nil
+}
+
+// Parse a json abi, or a compiler artifact with an "abi" field
+func ParseABI(b []byte) (*ABI, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '{' {
+		var artifact struct {
+			ABI json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(b, &artifact); err != nil {
+			return nil, fmt.Errorf("bad abi json: %v", err)
+		}
+		if len(artifact.ABI) == 0 {
+			return nil, fmt.Errorf("json object has no abi field")
+		}
+		b = artifact.ABI
+	}
+
+	var methods []*Method
+	if err := json.Unmarshal(b, &methods); err != nil {
+		return nil, fmt.Errorf("bad abi json: %v", err)
+	}
+	abi := new(ABI)
+	for _, m := range methods {
+		switch m.Type {
+		case "function", "":
+			abi.Functions = append(abi.Functions, m)
+		case "constructor":
+			abi.Constructor = m
+		case "error":
+			abi.Errors = append(abi.Errors, m)
+		case "event":
+			abi.Events = append(abi.Events, m)
+		}
+	}
+	return abi, nil
+}
+
+// Find a function by name, or by full signature if it's overloaded,
+// eg. "safeTransferFrom(address,address,uint256,bytes)"
+func (abi *ABI) Function(name string) (*Method, error) {
+	return findMethod(abi.Functions, name, "function")
+}
+
+func findMethod(methods []*Method, name, kind string) (*Method, error) {
+	name = strings.Replace(name, " ", "", -1)
+	if i := strings.Index(name, "("); i >= 0 {
+		sig, err := canonicalSignature(name[:i], name[i:])
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range methods {
+			if m.Signature() == sig {
+				return m, nil
+			}
+		}
+		return nil, fmt.Errorf("no %s %s in abi", kind, sig)
+	}
+
+	var found []*Method
+	for _, m := range methods {
+		if m.Name == name {
+			found = append(found, m)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no %s %s in abi", kind, name)
+	case 1:
+		return found[0], nil
+	}
+	sigs := make([]string, len(found))
+	for i, m := range found {
+		sigs[i] = m.Signature()
+	}
+	return nil, fmt.Errorf("%s %s is overloaded, pick one by signature: %s", kind, name, strings.Join(sigs, ", "))
+}
+
+// normalize the types of a user given signature (eg. uint -> uint256)
+func canonicalSignature(name, types string) (string, error) {
+	if types == "()" {
+		return name + types, nil
+	}
+	t, err := ParseType(types, nil)
+	if err != nil || t.Kind != TupleKind {
+		return "", fmt.Errorf("bad signature %s%s", name, types)
+	}
+	return name + t.String(), nil
+}
+
+// The call data given by the --data, --abi and --method flags of ethtx and
+// ethinfo: data as given, or the function in the abi file encoded with the args
+// from the command line (see Method.Pack). Also returns the abi and function,
+// which are nil if data was given
+func CallData(data, abiPath, function string, args []string) (string, *ABI, *Method, error) {
+	if abiPath == "" && function == "" {
+		if len(args) > 0 {
+			return "", nil, nil, fmt.Errorf("args can only be given with --abi and --method")
+		}
+		return data, nil, nil, nil
+	}
+	if abiPath == "" || function == "" {
+		return "", nil, nil, fmt.Errorf("--abi and --method must be given together")
+	}
+	if data != "" {
+		return "", nil, nil, fmt.Errorf("--data can not be used with --abi and --method")
+	}
+	abi, err := LoadABI(abiPath)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	m, err := abi.Function(function)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	b, err := m.Pack(args)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return fmt.Sprintf("0x%x", b), abi, m, nil
+}
@@ -0,0 +1,272 @@
+package abi
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// the examples from the solidity abi spec, a nested tuple and an overloaded function
+const testABI = `[
+	{"type": "function", "name": "baz", "inputs": [{"name": "x", "type": "uint32"}, {"name": "y", "type": "bool"}]},
+	{"type": "function", "name": "sam", "inputs": [{"type": "bytes"}, {"type": "bool"}, {"type": "uint256[]"}]},
+	{"type": "function", "name": "f", "inputs": [{"type": "uint256"}, {"type": "uint32[]"}, {"type": "bytes10"}, {"type": "bytes"}]},
+	{"type": "function", "name": "g", "inputs": [{"type": "uint256[][]"}, {"type": "string[]"}]},
+	{"type": "function", "name": "nested", "inputs": [{"name": "t", "type": "tuple", "components": [
+		{"name": "a", "type": "uint256"},
+		{"name": "b", "type": "tuple", "components": [{"name": "c", "type": "bool"}, {"name": "d", "type": "string"}]}
+	]}]},
+	{"type": "function", "name": "totalSupply", "inputs": []},
+	{"type": "function", "name": "signed", "inputs": [{"type": "int8"}, {"type": "int256"}]},
+	{"type": "function", "name": "safeTransferFrom", "inputs": [{"type": "address"}, {"type": "address"}, {"type": "uint256"}]},
+	{"type": "function", "name": "safeTransferFrom", "inputs": [{"type": "address"}, {"type": "address"}, {"type": "uint256"}, {"type": "bytes"}]}
+]`
+
+func words(w ...string) string {
+	return strings.Join(w, "")
+}
+
+var packTests = []struct {
+	function string
+	args     []string
+	data     string // without the selector
+}{
+	{"baz", []string{"69", "true"}, words(
+		"0000000000000000000000000000000000000000000000000000000000000045",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+	)},
+	// decimal, not octal
+	{"baz", []string{"010", "false"}, words(
+		"000000000000000000000000000000000000000000000000000000000000000a",
+		"0000000000000000000000000000000000000000000000000000000000000000",
+	)},
+	{"sam", []string{"0x64617665", "true", "[1,2,3]"}, words(
+		"0000000000000000000000000000000000000000000000000000000000000060",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"00000000000000000000000000000000000000000000000000000000000000a0",
+		"0000000000000000000000000000000000000000000000000000000000000004",
+		"6461766500000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000003",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000003",
+	)},
+	{"f", []string{"0x123", "[1110,1929]", "0x31323334353637383930", "0x48656c6c6f2c20776f726c6421"}, words(
+		"0000000000000000000000000000000000000000000000000000000000000123",
+		"0000000000000000000000000000000000000000000000000000000000000080",
+		"3132333435363738393000000000000000000000000000000000000000000000",
+		"00000000000000000000000000000000000000000000000000000000000000e0",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000456",
+		"0000000000000000000000000000000000000000000000000000000000000789",
+		"000000000000000000000000000000000000000000000000000000000000000d",
+		"48656c6c6f2c20776f726c642100000000000000000000000000000000000000",
+	)},
+	{"g", []string{"[[1,2],[3]]", `["one","two","three"]`}, words(
+		"0000000000000000000000000000000000000000000000000000000000000040",
+		"0000000000000000000000000000000000000000000000000000000000000140",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000040",
+		"00000000000000000000000000000000000000000000000000000000000000a0",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000003",
+		"0000000000000000000000000000000000000000000000000000000000000003",
+		"0000000000000000000000000000000000000000000000000000000000000060",
+		"00000000000000000000000000000000000000000000000000000000000000a0",
+		"00000000000000000000000000000000000000000000000000000000000000e0",
+		"0000000000000000000000000000000000000000000000000000000000000003",
+		"6f6e650000000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000003",
+		"74776f0000000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000005",
+		"7468726565000000000000000000000000000000000000000000000000000000",
+	)},
+	// a json array or object
+	{"nested", []string{`[1,[true,"x"]]`}, words(
+		"0000000000000000000000000000000000000000000000000000000000000020",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000040",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000040",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"7800000000000000000000000000000000000000000000000000000000000000",
+	)},
+	{"nested", []string{`{"a":1,"b":{"c":true,"d":"x"}}`}, words(
+		"0000000000000000000000000000000000000000000000000000000000000020",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000040",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000040",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"7800000000000000000000000000000000000000000000000000000000000000",
+	)},
+	{"signed", []string{"-1", "-0x80"}, words(
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff80",
+	)},
+	{"signed", []string{"127", "-57896044618658097711785492504343953926634992332820282019728792003956564819968"}, words(
+		"000000000000000000000000000000000000000000000000000000000000007f",
+		"8000000000000000000000000000000000000000000000000000000000000000",
+	)},
+}
+
+func TestPack(t *testing.T) {
+	abi, err := ParseABI([]byte(testABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range packTests {
+		m, err := abi.Function(test.function)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := m.Pack(test.args)
+		if err != nil {
+			t.Errorf("%s%v: %v", test.function, test.args, err)
+			continue
+		}
+		if got := hex.EncodeToString(data[4:]); got != test.data {
+			t.Errorf("%s%v encoded as\n%s\nwant\n%s", test.function, test.args, got, test.data)
+		}
+	}
+}
+
+func TestPackBadArgs(t *testing.T) {
+	abi, err := ParseABI([]byte(testABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		function string
+		args     []string
+	}{
+		{"baz", []string{"69"}},                      // too few
+		{"baz", []string{"0x100000000", "true"}},     // too big for uint32
+		{"baz", []string{"-1", "true"}},              // negative uint
+		{"baz", []string{"69", "1"}},                 // bools are words
+		{"signed", []string{"128", "0"}},             // too big for int8
+		{"signed", []string{"-129", "0"}},            // too small for int8
+		{"signed", []string{"--1", "0"}},             // double negative
+		{"sam", []string{"dave", "true", "[1,2,3]"}}, // bytes are hex
+		{"sam", []string{"0x64617665", "true", "1,2,3"}},
+		{"f", []string{"1", "[]", "0x" + strings.Repeat("31", 11), "0x"}}, // too long for bytes10
+		{"nested", []string{`[1,[true]]`}},
+		{"nested", []string{`{"a":1,"b":{"c":true,"d":"x"},"e":2}`}},
+	} {
+		m, err := abi.Function(test.function)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data, err := m.Pack(test.args); err == nil {
+			t.Errorf("%s%v encoded as %x", test.function, test.args, data)
+		}
+	}
+}
+
+func TestSelectors(t *testing.T) {
+	abi, err := ParseABI([]byte(testABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		function, signature, selector string
+	}{
+		{"baz", "baz(uint32,bool)", "cdcd77c0"},
+		{"sam", "sam(bytes,bool,uint256[])", "a5643bf2"},
+		{"f", "f(uint256,uint32[],bytes10,bytes)", "8be65246"},
+		{"g", "g(uint256[][],string[])", "2289b18c"},
+		{"nested", "nested((uint256,(bool,string)))", ""},
+		{"totalSupply()", "totalSupply()", "18160ddd"},
+		// overloads are picked by full signature, with types normalized
+		{"safeTransferFrom(address,address,uint256)", "safeTransferFrom(address,address,uint256)", "42842e0e"},
+		{"safeTransferFrom(address, address, uint, bytes)", "safeTransferFrom(address,address,uint256,bytes)", "b88d4fde"},
+	} {
+		m, err := abi.Function(test.function)
+		if err != nil {
+			t.Errorf("%s: %v", test.function, err)
+			continue
+		}
+		if m.Signature() != test.signature {
+			t.Errorf("%s: signature is %s, want %s", test.function, m.Signature(), test.signature)
+		}
+		if got := hex.EncodeToString(m.Selector()); test.selector != "" && got != test.selector {
+			t.Errorf("%s: selector is %s, want %s", test.function, got, test.selector)
+		}
+	}
+
+	for _, name := range []string{"safeTransferFrom", "transfer", "safeTransferFrom(address)", "baz(uint32,bool"} {
+		if m, err := abi.Function(name); err == nil {
+			t.Errorf("%s: found %s", name, m.Signature())
+		}
+	}
+}
+
+func TestParseType(t *testing.T) {
+	for _, test := range []struct {
+		s, canonical string
+		dynamic      bool
+	}{
+		{"uint", "uint256", false},
+		{"int8", "int8", false},
+		{"bytes32", "bytes32", false},
+		{"bytes", "bytes", true},
+		{"address[2]", "address[2]", false},
+		{"string[2]", "string[2]", true},
+		{"uint256[][3]", "uint256[][3]", true},
+		{"(uint, (bool,address)[2])", "(uint256,(bool,address)[2])", false},
+		{"(uint,string)[]", "(uint256,string)[]", true},
+	} {
+		typ, err := ParseType(test.s, nil)
+		if err != nil {
+			t.Errorf("%s: %v", test.s, err)
+			continue
+		}
+		if typ.String() != test.canonical || typ.Dynamic() != test.dynamic {
+			t.Errorf("%s parsed as %s (dynamic %v)", test.s, typ, typ.Dynamic())
+		}
+	}
+	for _, s := range []string{"uint7", "uint264", "int0", "bytes0", "bytes33", "address[0]", "uint[x]", "(uint", "tuple", "float"} {
+		if typ, err := ParseType(s, nil); err == nil {
+			t.Errorf("%s parsed as %s", s, typ)
+		}
+	}
+}
+
+func TestPackResolvesAddresses(t *testing.T) {
+	defer func() { ResolveAddress = nil }()
+	var resolved []string
+	ResolveAddress = func(name string) (string, error) {
+		resolved = append(resolved, name)
+		if name == "alice.eth" {
+			return "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil
+		}
+		return name, nil
+	}
+	arguments := []Argument{
+		{Type: Type{Kind: AddressKind, Size: 20}},
+		{Type: Type{Kind: SliceKind, Elem: &Type{Kind: AddressKind, Size: 20}}},
+		{Type: Type{Kind: TupleKind, Components: []Argument{{Name: "owner", Type: Type{Kind: AddressKind, Size: 20}}}}},
+	}
+	data, err := PackArgs(arguments, []string{"alice.eth", `["0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", "alice.eth"]`, `{"owner": "alice.eth"}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := words(
+		"0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0000000000000000000000000000000000000000000000000000000000000060",
+		"0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		"0000000000000000000000000000000000000000000000000000000000000002",
+		"000000000000000000000000fb6916095ca1df60bb79ce92ce3ea74c37c5d359",
+		"0000000000000000000000005aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+	)
+	if got := hex.EncodeToString(data); got != want {
+		t.Errorf("encoded as\n%s\nwant\n%s", got, want)
+	}
+	// hex is never a name
+	if len(resolved) != 3 {
+		t.Errorf("resolved %q, want alice.eth 3 times", resolved)
+	}
+}
@@ -0,0 +1,270 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/crypto"
+)
+
+//---------------------------------------------------------------
+// contract bytecode, as output by solc
+//
+// Bytecode that uses libraries has a 40 char placeholder wherever a
+// library's address goes: __$<first 34 hex chars of keccak256(name)>$__
+// with solc >= 0.5, or the name itself padded with underscores
+// (eg. __Lib____...) with older versions. Names are fully qualified
+// (eg. "contracts/Lib.sol:Lib"), though older solc leaves the source out.
+
+const placeholderLen = 40
+
+type Bytecode struct {
+	Code string // hex, possibly with placeholders
+
+	// fully qualified names of the libraries to link,
+	// if the bytecode came from an artifact that says
+	Libraries []string
+}
+
+// Load bytecode from a file of hex, or from a compiler artifact
+// with a "bytecode" field (hardhat, truffle, foundry) or "evm.bytecode"
+// (solc's standard json output for one contract)
+func LoadBytecode(path string) (*Bytecode, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	code, err := ParseBytecode(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return code, nil
+}
+
+func ParseBytecode(b []byte) (*Bytecode, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || b[0] != '{' {
+		return &Bytecode{Code: string(b)}, nil
+	}
+
+	var artifact struct {
+		Bytecode json.RawMessage `json:"bytecode"`
+		EVM      struct {
+			Bytecode json.RawMessage `json:"bytecode"`
+		} `json:"evm"`
+		LinkReferences linkReferences `json:"linkReferences"` // hardhat
+	}
+	if err := json.Unmarshal(b, &artifact); err != nil {
+		return nil, fmt.Errorf("bad artifact json: %v", err)
+	}
+	raw := artifact.Bytecode
+	if len(raw) == 0 {
+		raw = artifact.EVM.Bytecode
+	}
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("json object has no bytecode field")
+	}
+
+	// either the hex, or an object with it and the link references
+	var code struct {
+		Object         string         `json:"object"`
+		LinkReferences linkReferences `json:"linkReferences"`
+	}
+	if err := json.Unmarshal(raw, &code.Object); err != nil {
+		if err := json.Unmarshal(raw, &code); err != nil {
+			return nil, fmt.Errorf("bad bytecode field: %v", err)
+		}
+	} else {
+		code.LinkReferences = artifact.LinkReferences
+	}
+	if code.Object == "" {
+		return nil, fmt.Errorf("artifact has empty bytecode (is the contract abstract or an interface?)")
+	}
+	return &Bytecode{code.Object, code.LinkReferences.names()}, nil
+}
+
+// source file -> library name -> where it goes
+type linkReferences map[string]map[string]json.RawMessage
+
+func (refs linkReferences) names() []string {
+	var names []string
+	for source, libs := range refs {
+		for lib := range libs {
+			names = append(names, source+":"+lib)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Replace the library placeholders with their addresses, given by name
+// (fully qualified, or just the library's name) and return the code.
+// Fails if a placeholder is left, or a library isn't in the code
+func (b *Bytecode) Link(libs map[string]string) ([]byte, error) {
+	code := strings.Join(strings.Fields(b.Code), "")
+	if strings.HasPrefix(code, "0x") || strings.HasPrefix(code, "0X") {
+		code = code[2:]
+	}
+
+	var names []string
+	for name := range libs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		addr, err := toBytes(libs[name])
+		if err != nil || len(addr) != 20 {
+			return nil, fmt.Errorf("bad address for library %s: %s", name, libs[name])
+		}
+		linked := false
+		for _, p := range placeholders(code) {
+			if b.matches(p, name) {
+				code = strings.Replace(code, p, hex.EncodeToString(addr), -1)
+				linked = true
+			}
+		}
+		if !linked {
+			return nil, fmt.Errorf("library %s is not used by the bytecode", name)
+		}
+	}
+
+	if left := placeholders(code); len(left) > 0 {
+		unresolved := make([]string, len(left))
+		for i, p := range left {
+			unresolved[i] = b.describe(p)
+		}
+		return nil, fmt.Errorf("bytecode has unlinked libraries (link them with --link Name=0xaddr): %s", strings.Join(unresolved, ", "))
+	}
+
+	bin, err := hex.DecodeString(code)
+	if err != nil {
+		return nil, fmt.Errorf("bytecode is bad hex: %v", err)
+	}
+	return bin, nil
+}
+
+// the distinct placeholders in the code, in order. Hex has no
+// underscores, so a placeholder starts at any "__"
+func placeholders(code string) []string {
+	var found []string
+	seen := make(map[string]bool)
+	for i := 0; i < len(code); {
+		j := strings.Index(code[i:], "__")
+		if j < 0 {
+			break
+		}
+		i += j
+		end := i + placeholderLen
+		if end > len(code) {
+			end = len(code)
+		}
+		if p := code[i:end]; !seen[p] {
+			seen[p] = true
+			found = append(found, p)
+		}
+		i = end
+	}
+	return found
+}
+
+func (b *Bytecode) matches(p, name string) bool {
+	for _, fq := range b.qualifiedNames(name) {
+		if p == hashPlaceholder(fq) || p == namePlaceholder(fq) {
+			return true
+		}
+	}
+	// old style placeholders may hold a name we weren't told about
+	old := strings.TrimRight(strings.TrimPrefix(p, "__"), "_")
+	return strings.HasSuffix(old, ":"+name)
+}
+
+// the name, and the fully qualified names of artifact libraries it's short for
+func (b *Bytecode) qualifiedNames(name string) []string {
+	names := []string{name}
+	for _, fq := range b.Libraries {
+		if strings.HasSuffix(fq, ":"+name) {
+			names = append(names, fq)
+		}
+	}
+	return names
+}
+
+// name the library of a placeholder, if we can
+func (b *Bytecode) describe(p string) string {
+	if len(p) != placeholderLen {
+		return fmt.Sprintf("%s (truncated)", p)
+	}
+	for _, fq := range b.Libraries {
+		if p == hashPlaceholder(fq) || p == namePlaceholder(fq) {
+			return fmt.Sprintf("%s (%s)", fq, p)
+		}
+	}
+	if p[2] == '$' {
+		return p
+	}
+	return strings.TrimRight(p[2:], "_")
+}
+
+func hashPlaceholder(name string) string {
+	return "__$" + hex.EncodeToString(crypto.Keccak256([]byte(name)))[:34] + "$__"
+}
+
+func namePlaceholder(name string) string {
+	if len(name) > placeholderLen-4 {
+		name = name[:placeholderLen-4]
+	}
+	return "__" + name + strings.Repeat("_", placeholderLen-2-len(name))
+}
+
+// The init code of a contract: its linked bytecode (hex, or a file of it or
+// a compiler artifact) followed by the constructor args, encoded with the
+// abi at abiPath, or the artifact's own if abiPath is empty
+func InitCode(code, abiPath string, libs map[string]string, args []string) ([]byte, error) {
+	var bytecode *Bytecode
+	var err error
+	isFile := false
+	if _, statErr := os.Stat(code); statErr == nil {
+		isFile = true
+		if bytecode, err = LoadBytecode(code); err != nil {
+			return nil, err
+		}
+	} else {
+		bytecode = &Bytecode{Code: code}
+	}
+	linked, err := bytecode.Link(libs)
+	if err != nil {
+		return nil, err
+	}
+
+	var contract *ABI
+	if abiPath != "" {
+		if contract, err = LoadABI(abiPath); err != nil {
+			return nil, err
+		}
+	} else if isFile {
+		// the artifact may have the abi too
+		contract, _ = LoadABI(code)
+	}
+	if contract == nil {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("constructor args can only be given with --abi")
+		}
+		return linked, nil
+	}
+	var inputs []Argument
+	if contract.Constructor != nil {
+		inputs = contract.Constructor.Inputs
+	}
+	argData, err := PackArgs(inputs, args)
+	if err != nil {
+		return nil, fmt.Errorf("constructor: %v", err)
+	}
+	return append(linked, argData...), nil
+}
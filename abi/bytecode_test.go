@@ -0,0 +1,84 @@
+package abi
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+const (
+	libAddr = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+	// keccak256("contracts/Lib.sol:Lib"), as solc >= 0.5 writes it
+	hashLib = "__$6cf167dfb7c5c94c9fb5276b5691085b47$__"
+	// older solc
+	nameLib      = "__Lib___________________________________"
+	qualifiedLib = "__contracts/Lib.sol:Lib_________________"
+)
+
+func TestLink(t *testing.T) {
+	// hardhat's and solc's artifacts list the libraries
+	hardhat := `{"bytecode": "0x6000` + hashLib + `00", "linkReferences": {"contracts/Lib.sol": {"Lib": [{"start": 2, "length": 20}]}}}`
+	solc := `{"evm": {"bytecode": {"object": "6000` + hashLib + `00", "linkReferences": {"contracts/Lib.sol": {"Lib": [{"start": 2, "length": 20}]}}}}}`
+
+	linked := "60005aaeb6053f3e94c9b9a09f33669435e7ef1beaed00"
+	for _, test := range []struct {
+		code, lib, linked string // linked "" means an error
+	}{
+		{"0x6000" + hashLib + "00", "contracts/Lib.sol:Lib", linked},
+		{"6000" + hashLib + "00" + hashLib, "contracts/Lib.sol:Lib", linked + "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"},
+		{hardhat, "Lib", linked},
+		{hardhat, "contracts/Lib.sol:Lib", linked},
+		{solc, "Lib", linked},
+		{"6000" + nameLib + "00", "Lib", linked},
+		{"6000" + qualifiedLib + "00", "Lib", linked},
+		{"6000" + qualifiedLib + "00", "contracts/Lib.sol:Lib", linked},
+		// a hash can't be matched by anything but the name it's a hash of
+		{"6000" + hashLib + "00", "Lib", ""},
+		{"6000" + nameLib + "00", "Other", ""},
+		{hardhat, "Other", ""},
+	} {
+		bytecode, err := ParseBytecode([]byte(test.code))
+		if err != nil {
+			t.Fatal(err)
+		}
+		code, err := bytecode.Link(map[string]string{test.lib: libAddr})
+		if test.linked == "" {
+			if err == nil {
+				t.Errorf("%s linked into %s as %x", test.lib, test.code, code)
+			}
+		} else if got := hex.EncodeToString(code); err != nil || got != test.linked {
+			t.Errorf("%s linked into %s as %s (%v), want %s", test.lib, test.code, got, err, test.linked)
+		}
+	}
+}
+
+// placeholders left in the code fail, naming the library where they can
+func TestLinkUnresolved(t *testing.T) {
+	hardhat := `{"bytecode": "0x6000` + hashLib + `00", "linkReferences": {"contracts/Lib.sol": {"Lib": [{"start": 2, "length": 20}]}}}`
+	for _, test := range []struct {
+		code, named string
+	}{
+		{hardhat, "contracts/Lib.sol:Lib"},
+		{"6000" + hashLib + "00", hashLib},
+		{"6000" + nameLib + "00", "Lib"},
+		{"6000" + qualifiedLib + "00", "contracts/Lib.sol:Lib"},
+		{"6000__$6cf167dfb7c5", "truncated"},
+	} {
+		bytecode, err := ParseBytecode([]byte(test.code))
+		if err != nil {
+			t.Fatal(err)
+		}
+		code, err := bytecode.Link(nil)
+		if err == nil {
+			t.Errorf("%s linked as %x", test.code, code)
+		} else if !strings.Contains(err.Error(), test.named) {
+			t.Errorf("%s: error %q doesn't name %s", test.code, err, test.named)
+		}
+	}
+
+	bytecode := &Bytecode{Code: "6000" + nameLib + "00"}
+	if code, err := bytecode.Link(map[string]string{"Lib": "0x1234"}); err == nil {
+		t.Errorf("linked a bad address as %x", code)
+	}
+}
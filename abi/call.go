@@ -0,0 +1,49 @@
+package abi
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/eris-ltd/eth-client/utils"
+)
+
+//---------------------------------------------------------------
+// calls
+//
+// Read only calls of a contract's methods, with eth_call at the latest block.
+
+// Call the method with the args, and return what it returned. That's
+// nothing if there's no contract at the address (or it has no such method)
+func CallRaw(client *utils.Client, contract []byte, m *Method, args ...string) ([]byte, error) {
+	data, err := m.Pack(args)
+	if err != nil {
+		return nil, err
+	}
+	callArgs := map[string]string{
+		"to":   utils.HexString(contract),
+		"data": utils.HexString(data),
+	}
+	r, err := client.RequestResponse("eth", "call", callArgs, "latest")
+	if err != nil {
+		return nil, fmt.Errorf("Error calling %s: %v", m.Name, err)
+	}
+	s, _ := r.(string)
+	ret, err := hex.DecodeString(utils.StripHex(s))
+	if err != nil {
+		return nil, fmt.Errorf("node returned bad hex %v", r)
+	}
+	return ret, nil
+}
+
+// Call the method with the args, and decode what it returned.
+// It's an error for it to return nothing
+func CallMethod(client *utils.Client, contract []byte, m *Method, args ...string) ([]Value, error) {
+	ret, err := CallRaw(client, contract, m, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("%s has no %s()", utils.ChecksumAddress(contract), m.Name)
+	}
+	return m.Unpack(ret)
+}
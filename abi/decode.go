@@ -0,0 +1,259 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/utils"
+)
+
+//---------------------------------------------------------------
+// decoding
+//
+// Decoded values are json friendly: ints are decimal strings (so they
+// stay exact), addresses are checksummed, bytes are 0x hex, arrays are lists of values,
+// and tuples are lists of named, typed values like the top level.
+
+// A decoded value, with the name and type of its argument
+type Value struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// Decode the return data of a call to the method
+func (m *Method) Unpack(data []byte) ([]Value, error) {
+	values, err := UnpackArgs(m.Outputs, data)
+	if err != nil {
+		return nil, fmt.Errorf("%s returned %d bytes that don't decode: %v", m.Signature(), len(data), err)
+	}
+	return values, nil
+}
+
+func UnpackArgs(arguments []Argument, data []byte) ([]Value, error) {
+	types := make([]Type, len(arguments))
+	for i, a := range arguments {
+		types[i] = a.Type
+	}
+	decoded, err := decodeTuple(types, data)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]Value, len(arguments))
+	for i, a := range arguments {
+		values[i] = Value{a.Name, a.Type.String(), decoded[i]}
+	}
+	return values, nil
+}
+
+func decodeTuple(types []Type, data []byte) ([]interface{}, error) {
+	values := make([]interface{}, len(types))
+	pos := 0
+	for i, t := range types {
+		if pos+t.headSize() > len(data) {
+			return nil, fmt.Errorf("data too short for %s", t)
+		}
+		var err error
+		if t.Dynamic() {
+			var off int
+			if off, err = readInt(data[pos:], len(data)); err != nil {
+				return nil, fmt.Errorf("bad offset of %s: %v", t, err)
+			}
+			values[i], err = decode(t, data[off:])
+		} else {
+			values[i], err = decode(t, data[pos:])
+		}
+		if err != nil {
+			return nil, err
+		}
+		pos += t.headSize()
+	}
+	return values, nil
+}
+
+func decode(t Type, data []byte) (interface{}, error) {
+	if len(data) < 32 && t.Kind != ArrayKind && t.Kind != TupleKind {
+		return nil, fmt.Errorf("data too short for %s", t)
+	}
+	switch t.Kind {
+	case UintKind:
+		x := new(big.Int).SetBytes(data[:32])
+		if x.BitLen() > t.Size {
+			return nil, fmt.Errorf("%v does not fit in %s", x, t)
+		}
+		return x.String(), nil
+
+	case IntKind:
+		x := new(big.Int).SetBytes(data[:32])
+		if data[0]&0x80 != 0 {
+			x.Sub(x, new(big.Int).Lsh(big.NewInt(1), 256))
+		}
+		limit := new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1))
+		if x.Cmp(limit) >= 0 || x.Cmp(new(big.Int).Neg(limit)) < 0 {
+			return nil, fmt.Errorf("%v does not fit in %s", x, t)
+		}
+		return x.String(), nil
+
+	case AddressKind:
+		if !bytes.Equal(data[:12], make([]byte, 12)) {
+			return nil, fmt.Errorf("bad address %x", data[:32])
+		}
+		return utils.ChecksumAddress(data[12:32]), nil
+
+	case BoolKind:
+		switch x := new(big.Int).SetBytes(data[:32]); {
+		case x.Sign() == 0:
+			return false, nil
+		case x.Cmp(big.NewInt(1)) == 0:
+			return true, nil
+		}
+		return nil, fmt.Errorf("bad bool %x", data[:32])
+
+	case FixedBytesKind:
+		if !bytes.Equal(data[t.Size:32], make([]byte, 32-t.Size)) {
+			return nil, fmt.Errorf("bad %s %x", t, data[:32])
+		}
+		return fmt.Sprintf("0x%x", data[:t.Size]), nil
+
+	case BytesKind, StringKind:
+		n, err := readInt(data, len(data)-32)
+		if err != nil {
+			return nil, fmt.Errorf("bad length of %s: %v", t, err)
+		}
+		b := data[32 : 32+n]
+		if t.Kind == StringKind {
+			return string(b), nil
+		}
+		return fmt.Sprintf("0x%x", b), nil
+
+	case SliceKind, ArrayKind:
+		n := t.Size
+		if t.Kind == SliceKind {
+			var err error
+			if n, err = readInt(data, (len(data)-32)/t.Elem.headSize()); err != nil {
+				return nil, fmt.Errorf("bad length of %s: %v", t, err)
+			}
+			data = data[32:]
+		}
+		types := make([]Type, n)
+		for i := range types {
+			types[i] = *t.Elem
+		}
+		values, err := decodeTuple(types, data)
+		if err != nil {
+			return nil, err
+		}
+		if values == nil {
+			values = []interface{}{}
+		}
+		return values, nil
+
+	case TupleKind:
+		return UnpackArgs(t.Components, data)
+	}
+	return nil, fmt.Errorf("can't decode %s", t)
+}
+
+// read a 32 byte length or offset, which must be at most max
+func readInt(data []byte, max int) (int, error) {
+	if len(data) < 32 {
+		return 0, fmt.Errorf("data too short")
+	}
+	if !bytes.Equal(data[:24], make([]byte, 24)) {
+		return 0, fmt.Errorf("%x is too big", data[:32])
+	}
+	n := binary.BigEndian.Uint64(data[24:32])
+	if n > uint64(max) {
+		return 0, fmt.Errorf("%d is out of bounds", n)
+	}
+	return int(n), nil
+}
+
+//---------------------------------------------------------------
+// reverts
+
+var (
+	errorMethod = &Method{Type: "error", Name: "Error", Inputs: []Argument{{Name: "reason", Type: Type{Kind: StringKind}}}}
+	panicMethod = &Method{Type: "error", Name: "Panic", Inputs: []Argument{{Name: "code", Type: Type{Kind: UintKind, Size: 256}}}}
+)
+
+// what solidity's panic codes mean
+var panicReasons = map[string]string{
+	"0":  "generic compiler panic",
+	"1":  "assertion failed",
+	"17": "arithmetic overflow or underflow",
+	"18": "division or modulo by zero",
+	"33": "invalid enum value",
+	"34": "incorrectly encoded storage byte array",
+	"49": "pop() on an empty array",
+	"50": "array index out of bounds",
+	"65": "too much memory allocated",
+	"81": "call to an uninitialized internal function",
+}
+
+type Revert struct {
+	Error  *Method // nil if the data matched no known error
+	Args   []Value
+	Reason string // for Error(string) and Panic(uint256)
+	Data   []byte
+}
+
+// Decode the data of a reverted call: solidity's Error(string)
+// and Panic(uint256), or a custom error from the abi (which may be nil)
+func DecodeRevert(data []byte, abi *ABI) *Revert {
+	r := &Revert{Data: data}
+	if len(data) < 4 {
+		return r
+	}
+	errors := []*Method{errorMethod, panicMethod}
+	if abi != nil {
+		errors = append(errors, abi.Errors...)
+	}
+	for _, e := range errors {
+		if !bytes.Equal(e.Selector(), data[:4]) {
+			continue
+		}
+		args, err := UnpackArgs(e.Inputs, data[4:])
+		if err != nil {
+			continue
+		}
+		r.Error, r.Args = e, args
+		switch e {
+		case errorMethod:
+			r.Reason = args[0].Value.(string)
+		case panicMethod:
+			code := args[0].Value.(string)
+			reason, ok := panicReasons[code]
+			if !ok {
+				reason = "unknown panic code"
+			}
+			x, _ := new(big.Int).SetString(code, 10)
+			r.Reason = fmt.Sprintf("0x%x: %s", x, reason)
+		}
+		return r
+	}
+	return r
+}
+
+func (r *Revert) String() string {
+	switch {
+	case r.Error == nil && len(r.Data) == 0:
+		return "no reason given"
+	case r.Error == nil:
+		return fmt.Sprintf("unknown error data 0x%x", r.Data)
+	case r.Error == errorMethod || r.Error == panicMethod:
+		return fmt.Sprintf("%s: %s", r.Error.Name, r.Reason)
+	}
+	args := make([]string, len(r.Args))
+	for i, a := range r.Args {
+		if a.Name != "" {
+			args[i] = fmt.Sprintf("%s=%v", a.Name, a.Value)
+		} else {
+			args[i] = fmt.Sprint(a.Value)
+		}
+	}
+	return fmt.Sprintf("%s(%s)", r.Error.Name, strings.Join(args, ", "))
+}
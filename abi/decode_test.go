@@ -0,0 +1,126 @@
+package abi
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// decoding the spec examples gives back the args, in their canonical forms
+func TestUnpack(t *testing.T) {
+	abi, err := ParseABI([]byte(testABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		function string
+		values   string // json
+	}{
+		{"baz", `[{"name":"x","type":"uint32","value":"69"},{"name":"y","type":"bool","value":true}]`},
+		{"sam", `[{"name":"","type":"bytes","value":"0x64617665"},{"name":"","type":"bool","value":true},{"name":"","type":"uint256[]","value":["1","2","3"]}]`},
+		{"f", `[{"name":"","type":"uint256","value":"291"},{"name":"","type":"uint32[]","value":["1110","1929"]},` +
+			`{"name":"","type":"bytes10","value":"0x31323334353637383930"},{"name":"","type":"bytes","value":"0x48656c6c6f2c20776f726c6421"}]`},
+		{"g", `[{"name":"","type":"uint256[][]","value":[["1","2"],["3"]]},{"name":"","type":"string[]","value":["one","two","three"]}]`},
+		{"nested", `[{"name":"t","type":"(uint256,(bool,string))","value":[{"name":"a","type":"uint256","value":"1"},` +
+			`{"name":"b","type":"(bool,string)","value":[{"name":"c","type":"bool","value":true},{"name":"d","type":"string","value":"x"}]}]}]`},
+		{"signed", `[{"name":"","type":"int8","value":"-1"},{"name":"","type":"int256","value":"-128"}]`},
+	} {
+		m, err := abi.Function(test.function)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var data string
+		for _, p := range packTests {
+			if p.function == test.function {
+				data = p.data
+				break
+			}
+		}
+		values, err := UnpackArgs(m.Inputs, mustDecodeHex(t, data))
+		if err != nil {
+			t.Errorf("%s: %v", test.function, err)
+			continue
+		}
+		if got, _ := json.Marshal(values); string(got) != test.values {
+			t.Errorf("%s decoded as\n%s\nwant\n%s", test.function, got, test.values)
+		}
+	}
+}
+
+func TestUnpackBadData(t *testing.T) {
+	word := func(s string) string { return strings.Repeat("0", 64-len(s)) + s }
+	for _, test := range []struct {
+		types, data string
+	}{
+		{"(uint256,uint256)", word("1")},                                          // truncated
+		{"(uint8)", word("100")},                                                  // too big for uint8
+		{"(int8)", word("80")},                                                    // too big for int8
+		{"(bool)", word("2")},                                                     // not a bool
+		{"(address)", "01" + word("1")[2:]},                                       // dirty padding
+		{"(bytes2)", "abcd01" + strings.Repeat("0", 58)},                          // dirty padding
+		{"(string)", word("20") + word("21") + strings.Repeat("61", 32)},          // length out of bounds
+		{"(bytes)", word("40")},                                                   // offset out of bounds
+		{"(uint256[])", word("20") + word("3") + word("1") + word("2")},           // too few elements
+		{"(uint256[])", word("20") + "01" + word("1")[2:]},                        // huge length
+		{"(uint256[2][])", word("20") + word("1") + word("1")},                    // truncated array
+		{"((uint256,string))", word("20") + word("1") + word("40") + word("100")}, // nested string out of bounds
+	} {
+		typ, err := ParseType(test.types, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if values, err := UnpackArgs(typ.Components, mustDecodeHex(t, test.data)); err == nil {
+			t.Errorf("%s: decoded %s as %v", test.types, test.data, values)
+		}
+	}
+
+	// arrays of empty tuples would have no size
+	for _, s := range []string{"()", "()[]", "(uint256,())"} {
+		if typ, err := ParseType(s, nil); err == nil {
+			t.Errorf("%s parsed as %s", s, typ)
+		}
+	}
+}
+
+func TestDecodeRevert(t *testing.T) {
+	abi, err := ParseABI([]byte(`[{"type": "error", "name": "InsufficientBalance", "inputs": [
+		{"name": "available", "type": "uint256"}, {"name": "required", "type": "uint256"}]}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		data, want string
+	}{
+		{"", "no reason given"},
+		{"08c379a0" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"000000000000000000000000000000000000000000000000000000000000001a" +
+			"4e6f7420656e6f7567682045746865722070726f76696465642e000000000000",
+			"Error: Not enough Ether provided."},
+		{"4e487b71" + "0000000000000000000000000000000000000000000000000000000000000011",
+			"Panic: 0x11: arithmetic overflow or underflow"},
+		{"4e487b71" + "0000000000000000000000000000000000000000000000000000000000000099",
+			"Panic: 0x99: unknown panic code"},
+		{"cf479181" +
+			"0000000000000000000000000000000000000000000000000000000000000001" +
+			"0000000000000000000000000000000000000000000000000000000000000002",
+			"InsufficientBalance(available=1, required=2)"},
+		// truncated
+		{"08c379a0" + "0000000000000000000000000000000000000000000000000000000000000020",
+			"unknown error data 0x08c379a00000000000000000000000000000000000000000000000000000000000000020"},
+		{"4e487b", "unknown error data 0x4e487b"},
+	} {
+		if got := DecodeRevert(mustDecodeHex(t, test.data), abi).String(); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.data, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/utils"
+)
+
+//---------------------------------------------------------------
+// encoding
+//
+// Values come from the command line, so they start out as strings:
+// numbers are decimal or 0x hex, addresses (checksummed if mixed case) and bytes are hex,
+// bools are true or false. Arrays and tuples are json arrays of values
+// (eg. '[1,2,3]' or '["0x...", [1, true]]'), and tuples may also be
+// json objects keyed by component name.
+
+// If set, address args that aren't hex (eg. ENS names, or names in
+// an address book) are resolved with it before they're encoded
+var ResolveAddress func(name string) (string, error)
+
+// Encode a call: the selector followed by the encoded args
+func (m *Method) Pack(args []string) ([]byte, error) {
+	data, err := PackArgs(m.Inputs, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", m.Signature(), err)
+	}
+	return append(m.Selector(), data...), nil
+}
+
+// Encode args for the given arguments (eg. for a constructor)
+func PackArgs(arguments []Argument, args []string) ([]byte, error) {
+	if len(args) != len(arguments) {
+		return nil, fmt.Errorf("expected %d args %s, got %d", len(arguments), typesString(arguments), len(args))
+	}
+	types := make([]Type, len(arguments))
+	values := make([]interface{}, len(args))
+	for i, a := range arguments {
+		types[i] = a.Type
+		v, err := parseArg(a.Type, args[i])
+		if err == nil {
+			v, err = resolveAddresses(a.Type, v)
+		}
+		if err == nil {
+			// encode alone first, to say which arg is bad
+			_, err = encode(a.Type, v)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("arg %d (%s): %v", i, argName(a), err)
+		}
+		values[i] = v
+	}
+	return encodeTuple(types, values)
+}
+
+func argName(a Argument) string {
+	if a.Name != "" {
+		return a.Name + " " + a.Type.String()
+	}
+	return a.Type.String()
+}
+
+// composite values are json
+func parseArg(t Type, s string) (interface{}, error) {
+	switch t.Kind {
+	case SliceKind, ArrayKind, TupleKind:
+		dec := json.NewDecoder(strings.NewReader(s))
+		dec.UseNumber()
+		var v interface{}
+		if err := dec.Decode(&v); err != nil {
+			return nil, fmt.Errorf("%s is not a json value: %v", s, err)
+		}
+		return v, nil
+	}
+	return s, nil
+}
+
+// resolve the names in the address values of v (see ResolveAddress).
+// Values of the wrong shape are left for encode to complain about
+func resolveAddresses(t Type, v interface{}) (interface{}, error) {
+	if ResolveAddress == nil {
+		return v, nil
+	}
+	var err error
+	switch t.Kind {
+	case AddressKind:
+		if s, ok := v.(string); ok && !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+			return ResolveAddress(s)
+		}
+
+	case SliceKind, ArrayKind:
+		elems, _ := v.([]interface{})
+		for i := range elems {
+			if elems[i], err = resolveAddresses(*t.Elem, elems[i]); err != nil {
+				return nil, err
+			}
+		}
+
+	case TupleKind:
+		switch v := v.(type) {
+		case []interface{}:
+			for i := 0; i < len(v) && i < len(t.Components); i++ {
+				if v[i], err = resolveAddresses(t.Components[i].Type, v[i]); err != nil {
+					return nil, err
+				}
+			}
+		case map[string]interface{}:
+			for _, c := range t.Components {
+				if e, ok := v[c.Name]; ok {
+					if v[c.Name], err = resolveAddresses(c.Type, e); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+	return v, nil
+}
+
+func encodeTuple(types []Type, values []interface{}) ([]byte, error) {
+	headSize := 0
+	for _, t := range types {
+		headSize += t.headSize()
+	}
+	var head, tail []byte
+	for i, t := range types {
+		enc, err := encode(t, values[i])
+		if err != nil {
+			if len(types) > 1 {
+				return nil, fmt.Errorf("element %d: %v", i, err)
+			}
+			return nil, err
+		}
+		if t.Dynamic() {
+			head = append(head, padInt(big.NewInt(int64(headSize+len(tail))))...)
+			tail = append(tail, enc...)
+		} else {
+			head = append(head, enc...)
+		}
+	}
+	return append(head, tail...), nil
+}
+
+func encode(t Type, v interface{}) ([]byte, error) {
+	switch t.Kind {
+	case UintKind, IntKind:
+		x, err := toBig(v)
+		if err != nil {
+			return nil, err
+		}
+		return encodeInt(t, x)
+
+	case AddressKind:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected an address, got %v", v)
+		}
+		b, err := utils.ParseAddress(s)
+		if err != nil {
+			return nil, err
+		}
+		return leftPad(b), nil
+
+	case BoolKind:
+		switch fmt.Sprint(v) {
+		case "true":
+			return padInt(big.NewInt(1)), nil
+		case "false":
+			return padInt(new(big.Int)), nil
+		}
+		return nil, fmt.Errorf("bool must be true or false, got %v", v)
+
+	case FixedBytesKind:
+		b, err := toBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > t.Size {
+			return nil, fmt.Errorf("%s can't hold %d bytes", t, len(b))
+		}
+		return rightPad(b), nil
+
+	case BytesKind:
+		b, err := toBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		return append(padInt(big.NewInt(int64(len(b)))), rightPad(b)...), nil
+
+	case StringKind:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %v", v)
+		}
+		return append(padInt(big.NewInt(int64(len(s)))), rightPad([]byte(s))...), nil
+
+	case SliceKind, ArrayKind:
+		elems, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a json array for %s, got %v", t, v)
+		}
+		if t.Kind == ArrayKind && len(elems) != t.Size {
+			return nil, fmt.Errorf("%s needs %d elements, got %d", t, t.Size, len(elems))
+		}
+		types := make([]Type, len(elems))
+		for i := range types {
+			types[i] = *t.Elem
+		}
+		enc, err := encodeTuple(types, elems)
+		if err != nil {
+			return nil, err
+		}
+		if t.Kind == SliceKind {
+			enc = append(padInt(big.NewInt(int64(len(elems)))), enc...)
+		}
+		return enc, nil
+
+	case TupleKind:
+		elems, err := tupleValues(t, v)
+		if err != nil {
+			return nil, err
+		}
+		types := make([]Type, len(t.Components))
+		for i, c := range t.Components {
+			types[i] = c.Type
+		}
+		return encodeTuple(types, elems)
+	}
+	return nil, fmt.Errorf("can't encode %s", t)
+}
+
+// tuples are json arrays, or objects keyed by component name
+func tupleValues(t Type, v interface{}) ([]interface{}, error) {
+	switch v := v.(type) {
+	case []interface{}:
+		if len(v) != len(t.Components) {
+			return nil, fmt.Errorf("%s needs %d elements, got %d", t, len(t.Components), len(v))
+		}
+		return v, nil
+	case map[string]interface{}:
+		elems := make([]interface{}, len(t.Components))
+		for i, c := range t.Components {
+			e, ok := v[c.Name]
+			if !ok || c.Name == "" {
+				return nil, fmt.Errorf("tuple %s is missing component %q", t, c.Name)
+			}
+			elems[i] = e
+		}
+		if len(v) != len(elems) {
+			return nil, fmt.Errorf("tuple %s has unknown components", t)
+		}
+		return elems, nil
+	}
+	return nil, fmt.Errorf("expected a json array or object for %s, got %v", t, v)
+}
+
+// check the range and two's complement negative ints
+func encodeInt(t Type, x *big.Int) ([]byte, error) {
+	if t.Kind == UintKind {
+		if x.Sign() < 0 || x.BitLen() > t.Size {
+			return nil, fmt.Errorf("%v does not fit in %s", x, t)
+		}
+		return padInt(x), nil
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1))
+	if x.Cmp(limit) >= 0 || x.Cmp(new(big.Int).Neg(limit)) < 0 {
+		return nil, fmt.Errorf("%v does not fit in %s", x, t)
+	}
+	if x.Sign() < 0 {
+		x = new(big.Int).Add(x, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+	return padInt(x), nil
+}
+
+// numbers are decimal or 0x hex (not go's octal, binary or 1_000 literals)
+func toBig(v interface{}) (*big.Int, error) {
+	if _, ok := v.(bool); ok {
+		return nil, fmt.Errorf("expected a number, got %v", v)
+	}
+	s := fmt.Sprint(v)
+	digits, base := strings.TrimPrefix(s, "-"), 10
+	if strings.HasPrefix(digits, "0x") || strings.HasPrefix(digits, "0X") {
+		digits, base = digits[2:], 16
+	}
+	x, ok := new(big.Int).SetString(digits, base)
+	if ok && strings.HasPrefix(s, "-") {
+		x.Neg(x)
+	}
+	if !ok || strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		return nil, fmt.Errorf("%s is not an integer", s)
+	}
+	return x, nil
+}
+
+func toBytes(v interface{}) ([]byte, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected a hex string, got %v", v)
+	}
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		s = s[2:]
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%s is bad hex: %v", v, err)
+	}
+	return b, nil
+}
+
+func padInt(x *big.Int) []byte {
+	return leftPad(x.Bytes())
+}
+
+func leftPad(b []byte) []byte {
+	return append(make([]byte, 32-len(b)), b...)
+}
+
+// pad to a multiple of 32 bytes
+func rightPad(b []byte) []byte {
+	if len(b)%32 == 0 {
+		return b
+	}
+	return append(b, bytes.Repeat([]byte{0}, 32-len(b)%32)...)
+}
@@ -0,0 +1,216 @@
+package abi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//---------------------------------------------------------------
+// solidity types
+
+type Kind int
+
+const (
+	UintKind Kind = iota
+	IntKind
+	AddressKind
+	BoolKind
+	FixedBytesKind // bytes1 ... bytes32
+	BytesKind
+	StringKind
+	SliceKind // T[]
+	ArrayKind // T[k]
+	TupleKind
+)
+
+type Type struct {
+	Kind       Kind
+	Size       int        // bits of ints, bytes of fixed bytes, length of arrays
+	Elem       *Type      // of slices and arrays
+	Components []Argument // of tuples
+}
+
+// Parse a solidity type, eg. "uint256", "bytes32[]" or "tuple[2]".
+// Tuples take their components from the abi,
+// or can be written out, eg. "(uint256,address)[]"
+func ParseType(s string, components []Argument) (Type, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(s, "]") {
+		i := strings.LastIndex(s, "[")
+		if i < 0 {
+			return Type{}, fmt.Errorf("bad type %s", s)
+		}
+		elem, err := ParseType(s[:i], components)
+		if err != nil {
+			return Type{}, err
+		}
+		if s[i+1:len(s)-1] == "" {
+			return Type{Kind: SliceKind, Elem: &elem}, nil
+		}
+		n, err := strconv.Atoi(s[i+1 : len(s)-1])
+		if err != nil || n <= 0 {
+			return Type{}, fmt.Errorf("bad array length in type %s", s)
+		}
+		return Type{Kind: ArrayKind, Size: n, Elem: &elem}, nil
+	}
+
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		parts, err := splitTypes(s[1 : len(s)-1])
+		if err != nil {
+			return Type{}, err
+		}
+		if len(parts) == 0 {
+			return Type{}, fmt.Errorf("tuple type has no components")
+		}
+		components = make([]Argument, len(parts))
+		for i, p := range parts {
+			if components[i].Type, err = ParseType(p, nil); err != nil {
+				return Type{}, err
+			}
+		}
+		return Type{Kind: TupleKind, Components: components}, nil
+	}
+
+	switch {
+	case s == "tuple":
+		if len(components) == 0 {
+			return Type{}, fmt.Errorf("tuple type has no components")
+		}
+		return Type{Kind: TupleKind, Components: components}, nil
+	case s == "address":
+		return Type{Kind: AddressKind, Size: 20}, nil
+	case s == "bool":
+		return Type{Kind: BoolKind}, nil
+	case s == "string":
+		return Type{Kind: StringKind}, nil
+	case s == "bytes":
+		return Type{Kind: BytesKind}, nil
+	case strings.HasPrefix(s, "bytes"):
+		n, err := strconv.Atoi(s[len("bytes"):])
+		if err != nil || n < 1 || n > 32 {
+			return Type{}, fmt.Errorf("bad fixed bytes type %s", s)
+		}
+		return Type{Kind: FixedBytesKind, Size: n}, nil
+	case strings.HasPrefix(s, "uint"):
+		n, err := intSize(s[len("uint"):])
+		if err != nil {
+			return Type{}, fmt.Errorf("bad type %s: %v", s, err)
+		}
+		return Type{Kind: UintKind, Size: n}, nil
+	case strings.HasPrefix(s, "int"):
+		n, err := intSize(s[len("int"):])
+		if err != nil {
+			return Type{}, fmt.Errorf("bad type %s: %v", s, err)
+		}
+		return Type{Kind: IntKind, Size: n}, nil
+	}
+	return Type{}, fmt.Errorf("unsupported type %s", s)
+}
+
+// uint is an alias for uint256
+func intSize(s string) (int, error) {
+	if s == "" {
+		return 256, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 8 || n > 256 || n%8 != 0 {
+		return 0, fmt.Errorf("size must be a multiple of 8 up to 256")
+	}
+	return n, nil
+}
+
+// split a comma separated list of types, minding nested tuples
+func splitTypes(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses in %s", s)
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses in %s", s)
+	}
+	return append(parts, s[start:]), nil
+}
+
+// The canonical form of the type, as used in signatures
+func (t Type) String() string {
+	switch t.Kind {
+	case UintKind:
+		return fmt.Sprintf("uint%d", t.Size)
+	case IntKind:
+		return fmt.Sprintf("int%d", t.Size)
+	case AddressKind:
+		return "address"
+	case BoolKind:
+		return "bool"
+	case FixedBytesKind:
+		return fmt.Sprintf("bytes%d", t.Size)
+	case BytesKind:
+		return "bytes"
+	case StringKind:
+		return "string"
+	case SliceKind:
+		return t.Elem.String() + "[]"
+	case ArrayKind:
+		return fmt.Sprintf("%s[%d]", t.Elem, t.Size)
+	case TupleKind:
+		types := make([]string, len(t.Components))
+		for i, c := range t.Components {
+			types[i] = c.Type.String()
+		}
+		return "(" + strings.Join(types, ",") + ")"
+	}
+	return "unknown"
+}
+
+// Dynamic types are encoded in the tail, with an offset in the head
+func (t Type) Dynamic() bool {
+	switch t.Kind {
+	case BytesKind, StringKind, SliceKind:
+		return true
+	case ArrayKind:
+		return t.Elem.Dynamic()
+	case TupleKind:
+		for _, c := range t.Components {
+			if c.Type.Dynamic() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// the size of the type in the head of an encoding
+func (t Type) headSize() int {
+	if t.Dynamic() {
+		return 32
+	}
+	switch t.Kind {
+	case ArrayKind:
+		return t.Size * t.Elem.headSize()
+	case TupleKind:
+		size := 0
+		for _, c := range t.Components {
+			size += c.Type.headSize()
+		}
+		return size
+	}
+	return 32
+}
@@ -0,0 +1,180 @@
+package crypto
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/crypto/sha3"
+)
+
+// offset of the recovery code in a compact signature (see ecdsa.SignCompact)
+const compactSigMagicOffset = 27
+
+func Keccak256(data ...[]byte) []byte {
+	hw := sha3.NewKeccak256()
+	for _, d := range data {
+		hw.Write(d)
+	}
+	return hw.Sum(nil)
+}
+
+// Load a secp256k1 private key from its 32 raw bytes
+func ToPrivateKey(b []byte) (*secp256k1.PrivateKey, error) {
+	if len(b) != 32 {
+		return nil, fmt.Errorf("private key must be 32 bytes, got %d", len(b))
+	}
+	var k secp256k1.ModNScalar
+	if overflow := k.SetByteSlice(b); overflow || k.IsZero() {
+		return nil, fmt.Errorf("private key is not in the range [1, N-1]")
+	}
+	return secp256k1.NewPrivateKey(&k), nil
+}
+
+// The ethereum address of a public key: the last 20 bytes
+// of the keccak256 hash of the uncompressed key (without the 0x04 prefix)
+func PubkeyToAddress(pub *secp256k1.PublicKey) common.Address {
+	return common.BytesToAddress(Keccak256(pub.SerializeUncompressed()[1:])[12:])
+}
+
+func PrivkeyToAddress(priv *secp256k1.PrivateKey) common.Address {
+	return PubkeyToAddress(priv.PubKey())
+}
+
+// Sign a 32 byte hash with a deterministic (RFC6979), low-S signature.
+// The signature is in ethereum's R || S || recovery id form
+func Sign(hash []byte, priv *secp256k1.PrivateKey) (sig [65]byte, err error) {
+	if len(hash) != 32 {
+		return sig, fmt.Errorf("hash to sign must be 32 bytes, got %d", len(hash))
+	}
+	compact := ecdsa.SignCompact(priv, hash, false)
+	recid := compact[0] - compactSigMagicOffset
+	if recid > 1 {
+		// the x coordinate of the nonce point overflowed the group order.
+		// this is astronomically unlikely, and ethereum can't represent it
+		return sig, fmt.Errorf("signature has unsupported recovery id %d", recid)
+	}
+	copy(sig[:64], compact[1:])
+	sig[64] = recid
+	return sig, nil
+}
+
+// Check that R and S are in [1, N-1] and the recovery id is 0 or 1.
+// With lowS, S must also be in the lower half of the range, as
+// required for transactions since homestead (EIP-2)
+func ValidSignatureValues(sig [65]byte, lowS bool) bool {
+	if sig[64] > 1 {
+		return false
+	}
+	var r, s secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(sig[:32]); overflow || r.IsZero() {
+		return false
+	}
+	if overflow := s.SetByteSlice(sig[32:64]); overflow || s.IsZero() {
+		return false
+	}
+	return !lowS || !s.IsOverHalfOrder()
+}
+
+// Recover the public key from an R || S || recovery id signature over hash
+func Recover(hash []byte, sig [65]byte) (*secp256k1.PublicKey, error) {
+	if len(hash) != 32 {
+		return nil, fmt.Errorf("signed hash must be 32 bytes, got %d", len(hash))
+	}
+	if sig[64] > 1 {
+		return nil, fmt.Errorf("invalid recovery id %d", sig[64])
+	}
+	compact := make([]byte, 65)
+	compact[0] = compactSigMagicOffset + sig[64]
+	copy(compact[1:], sig[:64])
+	pub, _, err := ecdsa.RecoverCompact(compact, hash)
+	if err != nil {
+		return nil, err
+	}
+	return pub, nil
+}
+
+// Recover the address that made an R || S || recovery id signature over hash
+func RecoverAddress(hash []byte, sig [65]byte) (common.Address, error) {
+	pub, err := Recover(hash, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return PubkeyToAddress(pub), nil
+}
+
+//---------------------------------------------------------------
+// signed messages (EIP-191)
+//
+// Messages are signed under a prefix, so a signed message can never
+// be a valid transaction. Their signatures carry V as 27 or 28.
+
+// The hash personal_sign signs for a message:
+// keccak256("\x19Ethereum Signed Message:\n" + len(msg) + msg)
+func TextHash(msg []byte) []byte {
+	return Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(msg))), msg)
+}
+
+// An R || S || recovery id signature in message form, with V 27 or 28
+func MessageSignature(sig [65]byte) []byte {
+	b := make([]byte, 65)
+	copy(b, sig[:])
+	b[64] += compactSigMagicOffset
+	return b
+}
+
+// Parse a message signature back to R || S || recovery id form.
+// V may be 27 or 28, or the recovery id itself
+func ParseMessageSignature(b []byte) (sig [65]byte, err error) {
+	if len(b) != 65 {
+		return sig, fmt.Errorf("signature must be 65 bytes, got %d", len(b))
+	}
+	copy(sig[:], b)
+	if sig[64] >= compactSigMagicOffset {
+		sig[64] -= compactSigMagicOffset
+	}
+	if !ValidSignatureValues(sig, false) {
+		return sig, fmt.Errorf("signature has an invalid R, S or V")
+	}
+	return sig, nil
+}
+
+//---------------------------------------------------------------
+// contract addresses (CREATE2)
+//
+// A contract created with CREATE2 gets an address from its deployer, a salt
+// and its init code, rather than the deployer's nonce, so it can be known
+// (and be the same on every chain) before it's deployed.
+
+// The deterministic deployment proxy (github.com/Arachnid/deterministic-deployment-proxy),
+// a factory at the same address on every chain, which takes a salt followed by init code
+const DeploymentProxy = "0x4e59b44847b379578588920cA78FbF26c0B4956C"
+
+// keccak256(0xff ++ deployer ++ salt ++ keccak256(initCode))[12:]
+func Create2Address(deployer, salt, initCode []byte) []byte {
+	return Keccak256([]byte{0xff}, deployer, salt, Keccak256(initCode))[12:]
+}
+
+// A 32 byte salt, from hex (left padded with zeros if shorter) or a decimal number
+func ParseSalt(s string) ([]byte, error) {
+	var x *big.Int
+	var ok bool
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		if len(s) > 66 {
+			return nil, fmt.Errorf("salt %s is more than 32 bytes", s)
+		}
+		x, ok = new(big.Int).SetString(s[2:], 16)
+	} else {
+		x, ok = new(big.Int).SetString(s, 10)
+	}
+	if !ok || x.Sign() < 0 || x.BitLen() > 256 {
+		return nil, fmt.Errorf("bad salt %s (must be hex or a number)", s)
+	}
+	salt := make([]byte, 32)
+	b := x.Bytes()
+	copy(salt[32-len(b):], b)
+	return salt, nil
+}
@@ -0,0 +1,55 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// the examples from EIP-1014
+var create2Tests = []struct {
+	deployer, salt, initCode, addr string
+}{
+	{"0000000000000000000000000000000000000000", "0000000000000000000000000000000000000000000000000000000000000000", "00", "4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38"},
+	{"deadbeef00000000000000000000000000000000", "0000000000000000000000000000000000000000000000000000000000000000", "00", "B928f69Bb1D91Cd65274e3c79d8986362984fDA3"},
+	{"deadbeef00000000000000000000000000000000", "000000000000000000000000feed000000000000000000000000000000000000", "00", "D04116cDd17beBE565EB2422F2497E06cC1C9833"},
+	{"0000000000000000000000000000000000000000", "0000000000000000000000000000000000000000000000000000000000000000", "deadbeef", "70f2b2914A2a4b783FaEFb75f459A580616Fcb5e"},
+	{"00000000000000000000000000000000deadbeef", "00000000000000000000000000000000000000000000000000000000cafebabe", "deadbeef", "60f3f640a8508fC6a86d45DF051962668E1e8AC7"},
+	{"00000000000000000000000000000000deadbeef", "00000000000000000000000000000000000000000000000000000000cafebabe", strings.Repeat("deadbeef", 11), "1d8bfDC5D46DC4f61D6b6115972536eBE6A8854C"},
+	{"0000000000000000000000000000000000000000", "0000000000000000000000000000000000000000000000000000000000000000", "", "E33C0C7F7df4809055C3ebA6c09CFe4BaF1BD9e0"},
+}
+
+func TestCreate2Address(t *testing.T) {
+	for i, test := range create2Tests {
+		deployer, _ := hex.DecodeString(test.deployer)
+		salt, _ := hex.DecodeString(test.salt)
+		initCode, _ := hex.DecodeString(test.initCode)
+		if got := hex.EncodeToString(Create2Address(deployer, salt, initCode)); got != strings.ToLower(test.addr) {
+			t.Errorf("example %d: address is %s, want %s", i, got, test.addr)
+		}
+	}
+}
+
+func TestParseSalt(t *testing.T) {
+	for _, test := range []struct {
+		s, salt string // salt "" means an error
+	}{
+		{"0", "0000000000000000000000000000000000000000000000000000000000000000"},
+		{"42", "000000000000000000000000000000000000000000000000000000000000002a"},
+		{"0xcafebabe", "00000000000000000000000000000000000000000000000000000000cafebabe"},
+		{"0x" + strings.Repeat("ff", 32), strings.Repeat("ff", 32)},
+		{"0x" + strings.Repeat("ff", 33), ""},
+		{"-1", ""},
+		{"0xzz", ""},
+		{"salt", ""},
+	} {
+		salt, err := ParseSalt(test.s)
+		if test.salt == "" {
+			if err == nil {
+				t.Errorf("%s: parsed as %x", test.s, salt)
+			}
+		} else if got := hex.EncodeToString(salt); err != nil || got != test.salt {
+			t.Errorf("%s: got %s (%v), want %s", test.s, got, err, test.salt)
+		}
+	}
+}
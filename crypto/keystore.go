@@ -0,0 +1,205 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/golang.org/x/crypto/pbkdf2"
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/golang.org/x/crypto/scrypt"
+)
+
+//---------------------------------------------------------------
+// Web3 Secret Storage (v3 keystore files), as written by geth, parity, etc.
+// See https://github.com/ethereum/wiki/wiki/Web3-Secret-Storage-Definition
+
+type Key struct {
+	Address    common.Address
+	PrivateKey *secp256k1.PrivateKey
+}
+
+type keyJSONV3 struct {
+	Address string     `json:"address"`
+	Crypto  cryptoJSON `json:"crypto"` // some clients write "Crypto"
+	Id      string     `json:"id"`
+	Version int        `json:"version"`
+}
+
+type cryptoJSON struct {
+	Cipher       string                 `json:"cipher"`
+	CipherText   string                 `json:"ciphertext"`
+	CipherParams cipherParamsJSON       `json:"cipherparams"`
+	KDF          string                 `json:"kdf"`
+	KDFParams    map[string]interface{} `json:"kdfparams"`
+	MAC          string                 `json:"mac"`
+}
+
+type cipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+// Decrypt a v3 keystore file
+func DecryptKey(keyJSON []byte, passphrase string) (*Key, error) {
+	k := new(keyJSONV3)
+	if err := json.Unmarshal(keyJSON, k); err != nil {
+		return nil, fmt.Errorf("bad keystore json: %v", err)
+	}
+	if k.Version != 3 {
+		return nil, fmt.Errorf("unsupported keystore version %d", k.Version)
+	}
+	if k.Crypto.Cipher != "aes-128-ctr" {
+		return nil, fmt.Errorf("unsupported cipher %s", k.Crypto.Cipher)
+	}
+
+	mac, err := hex.DecodeString(k.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("mac is bad hex: %v", err)
+	}
+	iv, err := hex.DecodeString(k.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("iv is bad hex: %v", err)
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, fmt.Errorf("iv must be %d bytes, got %d", aes.BlockSize, len(iv))
+	}
+	cipherText, err := hex.DecodeString(k.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("ciphertext is bad hex: %v", err)
+	}
+
+	derivedKey, err := deriveKey(&k.Crypto, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(Keccak256(derivedKey[16:32], cipherText), mac) {
+		return nil, fmt.Errorf("could not decrypt key with given passphrase")
+	}
+
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return nil, err
+	}
+	privBytes := make([]byte, len(cipherText))
+	cipher.NewCTR(block, iv).XORKeyStream(privBytes, cipherText)
+
+	priv, err := ToPrivateKey(privBytes)
+	if err != nil {
+		return nil, err
+	}
+	key := &Key{
+		Address:    PrivkeyToAddress(priv),
+		PrivateKey: priv,
+	}
+	if k.Address != "" {
+		addr, err := hex.DecodeString(strings.TrimPrefix(k.Address, "0x"))
+		if err != nil || !bytes.Equal(addr, key.Address.Bytes()) {
+			return nil, fmt.Errorf("key decrypts to address %x, but the file claims %s", key.Address, k.Address)
+		}
+	}
+	return key, nil
+}
+
+// The most scrypt work a key file can ask for, since its params pick how much
+// memory (128*n*r bytes) and time decrypting takes. geth's are n=2^18, r=8, p=1
+const (
+	maxScryptN = 1 << 20
+	maxScryptR = 8
+	maxScryptP = 16
+)
+
+func deriveKey(c *cryptoJSON, passphrase string) ([]byte, error) {
+	salt, err := hex.DecodeString(kdfString(c.KDFParams, "salt"))
+	if err != nil {
+		return nil, fmt.Errorf("kdf salt is bad hex: %v", err)
+	}
+	dkLen := kdfInt(c.KDFParams, "dklen")
+	if dkLen < 32 {
+		return nil, fmt.Errorf("kdf dklen must be at least 32, got %d", dkLen)
+	}
+
+	switch c.KDF {
+	case "scrypt":
+		n := kdfInt(c.KDFParams, "n")
+		r := kdfInt(c.KDFParams, "r")
+		p := kdfInt(c.KDFParams, "p")
+		if n > maxScryptN || r > maxScryptR || p > maxScryptP {
+			return nil, fmt.Errorf("scrypt params n=%d, r=%d, p=%d are above the limits n=%d, r=%d, p=%d", n, r, p, maxScryptN, maxScryptR, maxScryptP)
+		}
+		return scrypt.Key([]byte(passphrase), salt, n, r, p, dkLen)
+	case "pbkdf2":
+		if prf := kdfString(c.KDFParams, "prf"); prf != "hmac-sha256" {
+			return nil, fmt.Errorf("unsupported pbkdf2 prf %s", prf)
+		}
+		iter := kdfInt(c.KDFParams, "c")
+		return pbkdf2.Key([]byte(passphrase), salt, iter, dkLen, sha256.New), nil
+	}
+	return nil, fmt.Errorf("unsupported kdf %s", c.KDF)
+}
+
+func kdfString(params map[string]interface{}, name string) string {
+	s, _ := params[name].(string)
+	return s
+}
+
+// json numbers decode as float64
+func kdfInt(params map[string]interface{}, name string) int {
+	f, _ := params[name].(float64)
+	return int(f)
+}
+
+// Find the keystore file for addr in dir.
+// Files are matched on the address they declare
+func FindKeyFile(dir string, addr common.Address) (string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	for _, f := range files {
+		if f.IsDir() || strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(dir, f.Name())
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		k := new(keyJSONV3)
+		if err := json.Unmarshal(b, k); err != nil {
+			continue
+		}
+		fileAddr, err := hex.DecodeString(strings.TrimPrefix(k.Address, "0x"))
+		if err != nil {
+			continue
+		}
+		if bytes.Equal(fileAddr, addr.Bytes()) {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("no key for address %x in keystore %s", addr, dir)
+}
+
+// Find and decrypt the key for addr in the keystore dir
+func LoadKey(dir string, addr common.Address, passphrase string) (*Key, error) {
+	path, err := FindKeyFile(dir, addr)
+	if err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := DecryptKey(b, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return key, nil
+}
@@ -0,0 +1,84 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// the test vectors of the Web3 Secret Storage Definition, with the passphrase "testpassword"
+var keystoreTests = []struct {
+	name string
+	json string
+}{
+	{"pbkdf2", `{
+		"crypto": {
+			"cipher": "aes-128-ctr",
+			"cipherparams": {"iv": "6087dab2f9fdbbfaddc31a909735c1e6"},
+			"ciphertext": "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+			"kdf": "pbkdf2",
+			"kdfparams": {"c": 262144, "dklen": 32, "prf": "hmac-sha256", "salt": "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},
+			"mac": "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+		},
+		"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+		"version": 3
+	}`},
+	{"scrypt", `{
+		"crypto": {
+			"cipher": "aes-128-ctr",
+			"cipherparams": {"iv": "83dbcc02d8ccb40e466191a123791e0e"},
+			"ciphertext": "d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c",
+			"kdf": "scrypt",
+			"kdfparams": {"dklen": 32, "n": 262144, "r": 1, "p": 8, "salt": "ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},
+			"mac": "2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"
+		},
+		"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+		"version": 3
+	}`},
+}
+
+const (
+	keystoreKey     = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+	keystoreAddress = "008aeeda4d805471df9b2a5b0f38a0c3bcba786b"
+)
+
+func TestDecryptKey(t *testing.T) {
+	for _, test := range keystoreTests {
+		key, err := DecryptKey([]byte(test.json), "testpassword")
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if got := hex.EncodeToString(key.PrivateKey.Serialize()); got != keystoreKey {
+			t.Errorf("%s: key is %s, want %s", test.name, got, keystoreKey)
+		}
+		if got := hex.EncodeToString(key.Address.Bytes()); got != keystoreAddress {
+			t.Errorf("%s: address is %s, want %s", test.name, got, keystoreAddress)
+		}
+
+		if _, err := DecryptKey([]byte(test.json), "wrongpassword"); err == nil {
+			t.Errorf("%s: decrypted with the wrong passphrase", test.name)
+		}
+	}
+}
+
+// bad files are errors, not panics
+func TestDecryptKeyBadParams(t *testing.T) {
+	pbkdf2, scrypt := keystoreTests[0].json, keystoreTests[1].json
+	for _, test := range []struct {
+		name, json, old, new string
+	}{
+		{"short iv", pbkdf2, `"iv": "6087dab2f9fdbbfaddc31a909735c1e6"`, `"iv": "6087dab2f9"`},
+		{"short dklen", pbkdf2, `"dklen": 32`, `"dklen": 16`},
+		{"unknown cipher", pbkdf2, `"aes-128-ctr"`, `"aes-256-gcm"`},
+		{"unknown kdf", pbkdf2, `"kdf": "pbkdf2"`, `"kdf": "argon2"`},
+		{"huge scrypt n", scrypt, `"n": 262144`, `"n": 2097152`},
+		{"huge scrypt r", scrypt, `"r": 1`, `"r": 1024`},
+		{"huge scrypt p", scrypt, `"p": 8`, `"p": 1024`},
+	} {
+		json := strings.Replace(test.json, test.old, test.new, 1)
+		if _, err := DecryptKey([]byte(json), "testpassword"); err == nil {
+			t.Errorf("%s: decrypted", test.name)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package eip712
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/abi"
+	"github.com/eris-ltd/eth-client/crypto"
+	"github.com/eris-ltd/eth-client/utils"
+)
+
+//---------------------------------------------------------------
+// typed structured data (EIP-712)
+//
+// A message is a struct, signed along with a domain (the app, its version,
+// chain and contract) so a signature for one app can't be replayed on another.
+// Structs are hashed as keccak256(typeHash . encoded fields), where the
+// typeHash covers the struct's type and every struct type it refers to,
+// nested structs are hashed in place, and strings, bytes and arrays are
+// replaced by their hashes. The signed hash is
+// keccak256("\x19\x01" . hashStruct(domain) . hashStruct(message)).
+
+const domainType = "EIP712Domain"
+
+// the domain's fields, in the order they go in EIP712Domain
+var domainFields = []Field{
+	{"name", "string"},
+	{"version", "string"},
+	{"chainId", "uint256"},
+	{"verifyingContract", "address"},
+	{"salt", "bytes32"},
+}
+
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Typed data as taken by eth_signTypedData_v4
+type TypedData struct {
+	Types       map[string][]Field     `json:"types"`
+	PrimaryType string                 `json:"primaryType"`
+	Domain      map[string]interface{} `json:"domain"`
+	Message     map[string]interface{} `json:"message"`
+}
+
+// Load typed data from a json file
+func Load(path string) (*TypedData, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	td, err := Parse(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return td, nil
+}
+
+func Parse(b []byte) (*TypedData, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	// keep big numbers exact
+	dec.UseNumber()
+	td := new(TypedData)
+	if err := dec.Decode(td); err != nil {
+		return nil, fmt.Errorf("bad typed data json: %v", err)
+	}
+	if td.PrimaryType == "" {
+		return nil, fmt.Errorf("typed data has no primaryType")
+	}
+	if td.Types == nil {
+		td.Types = make(map[string][]Field)
+	}
+	if _, ok := td.Types[td.PrimaryType]; !ok {
+		return nil, fmt.Errorf("primary type %s is not in the types", td.PrimaryType)
+	}
+	if _, ok := td.Types[domainType]; !ok {
+		// the domain's type follows from the fields it has
+		var fields []Field
+		for _, f := range domainFields {
+			if _, ok := td.Domain[f.Name]; ok {
+				fields = append(fields, f)
+			}
+		}
+		td.Types[domainType] = fields
+	}
+	return td, nil
+}
+
+// The hash to sign
+func (td *TypedData) Hash() ([]byte, error) {
+	domain, err := td.DomainSeparator()
+	if err != nil {
+		return nil, err
+	}
+	if td.PrimaryType == domainType {
+		return crypto.Keccak256([]byte{0x19, 0x01}, domain), nil
+	}
+	message, err := td.HashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, fmt.Errorf("message: %v", err)
+	}
+	return crypto.Keccak256([]byte{0x19, 0x01}, domain, message), nil
+}
+
+func (td *TypedData) DomainSeparator() ([]byte, error) {
+	domain, err := td.HashStruct(domainType, td.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("domain: %v", err)
+	}
+	return domain, nil
+}
+
+// keccak256(typeHash . encoded fields)
+func (td *TypedData) HashStruct(name string, data map[string]interface{}) ([]byte, error) {
+	enc, err := td.EncodeData(name, data)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(enc), nil
+}
+
+// The type of a struct and the struct types it refers to (sorted by name), eg.
+// Mail(Person from,Person to,string contents)Person(string name,address wallet)
+func (td *TypedData) EncodeType(name string) (string, error) {
+	deps := make(map[string]bool)
+	if err := td.dependencies(name, deps); err != nil {
+		return "", err
+	}
+	delete(deps, name)
+	names := []string{name}
+	for dep := range deps {
+		names = append(names, dep)
+	}
+	sort.Strings(names[1:])
+
+	var s string
+	for _, n := range names {
+		fields := make([]string, len(td.Types[n]))
+		for i, f := range td.Types[n] {
+			fields[i] = f.Type + " " + f.Name
+		}
+		s += n + "(" + strings.Join(fields, ",") + ")"
+	}
+	return s, nil
+}
+
+func (td *TypedData) dependencies(name string, deps map[string]bool) error {
+	if deps[name] {
+		return nil
+	}
+	fields, ok := td.Types[name]
+	if !ok {
+		return fmt.Errorf("unknown type %s", name)
+	}
+	deps[name] = true
+	for _, f := range fields {
+		if t := baseType(f.Type); td.isStruct(t) {
+			if err := td.dependencies(t, deps); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// The type hash followed by each field's 32 byte encoding
+func (td *TypedData) EncodeData(name string, data map[string]interface{}) ([]byte, error) {
+	typ, err := td.EncodeType(name)
+	if err != nil {
+		return nil, err
+	}
+	fields := td.Types[name]
+	if len(data) > len(fields) {
+		return nil, fmt.Errorf("%s has fields that aren't in its type", name)
+	}
+	enc := crypto.Keccak256([]byte(typ))
+	for _, f := range fields {
+		v, ok := data[f.Name]
+		if !ok {
+			return nil, fmt.Errorf("%s is missing field %s", name, f.Name)
+		}
+		b, err := td.encodeValue(f.Type, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %v", name, f.Name, err)
+		}
+		enc = append(enc, b...)
+	}
+	return enc, nil
+}
+
+func (td *TypedData) encodeValue(typ string, v interface{}) ([]byte, error) {
+	if strings.HasSuffix(typ, "]") {
+		// arrays are hashed, as the concatenation of their elements
+		i := strings.LastIndex(typ, "[")
+		if i < 0 {
+			return nil, fmt.Errorf("bad type %s", typ)
+		}
+		elems, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a json array for %s, got %v", typ, v)
+		}
+		if n := typ[i+1 : len(typ)-1]; n != "" {
+			if size, err := strconv.Atoi(n); err != nil || size != len(elems) {
+				return nil, fmt.Errorf("%s needs %s elements, got %d", typ, n, len(elems))
+			}
+		}
+		var enc []byte
+		for j, e := range elems {
+			b, err := td.encodeValue(typ[:i], e)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %v", j, err)
+			}
+			enc = append(enc, b...)
+		}
+		return crypto.Keccak256(enc), nil
+	}
+
+	if td.isStruct(typ) {
+		data, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a json object for %s, got %v", typ, v)
+		}
+		return td.HashStruct(typ, data)
+	}
+
+	switch typ {
+	case "string":
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %v", v)
+		}
+		return crypto.Keccak256([]byte(s)), nil
+	case "bytes":
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a hex string, got %v", v)
+		}
+		b, err := hex.DecodeString(utils.StripHex(s))
+		if err != nil {
+			return nil, fmt.Errorf("%s is bad hex: %v", s, err)
+		}
+		return crypto.Keccak256(b), nil
+	}
+
+	// the rest are encoded as in the abi
+	t, err := abi.ParseType(typ, nil)
+	if err != nil {
+		return nil, err
+	}
+	if t.Dynamic() || t.Kind == abi.TupleKind {
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+	return abi.PackArgs([]abi.Argument{{Type: t}}, []string{fmt.Sprint(v)})
+}
+
+func (td *TypedData) isStruct(typ string) bool {
+	_, ok := td.Types[typ]
+	return ok
+}
+
+// the type of an array's elements, all the way down
+func baseType(typ string) string {
+	if i := strings.Index(typ, "["); i >= 0 {
+		return typ[:i]
+	}
+	return typ
+}
@@ -0,0 +1,91 @@
+package eip712
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/eris-ltd/eth-client/crypto"
+)
+
+// the example from EIP-712
+const mail = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func TestMail(t *testing.T) {
+	td, err := Parse([]byte(mail))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encodeType := "Mail(Person from,Person to,string contents)Person(string name,address wallet)"
+	if got, err := td.EncodeType("Mail"); err != nil || got != encodeType {
+		t.Errorf("encodeType is %q (%v), want %q", got, err, encodeType)
+	}
+	for _, test := range []struct {
+		name string
+		hash func() ([]byte, error)
+		want string
+	}{
+		{"domain separator", td.DomainSeparator, "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f"},
+		{"message hash", func() ([]byte, error) { return td.HashStruct("Mail", td.Message) }, "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e"},
+		{"digest", td.Hash, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"},
+	} {
+		h, err := test.hash()
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		} else if got := hex.EncodeToString(h); got != test.want {
+			t.Errorf("%s is %s, want %s", test.name, got, test.want)
+		}
+	}
+
+	// the example's signature, by the key keccak256("cow")
+	digest, err := td.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := hex.DecodeString("4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d" +
+		"07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562" + "1c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rawSig, err := crypto.ParseMessageSignature(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := crypto.RecoverAddress(digest, rawSig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hex.EncodeToString(addr.Bytes()); got != "cd2a3d9f938e13cd947ec05abc7fe734df8dd826" {
+		t.Errorf("signed by %s, want cow's 0xcd2a3d9f938e13cd947ec05abc7fe734df8dd826", got)
+	}
+}
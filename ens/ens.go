@@ -0,0 +1,177 @@
+package ens
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/abi"
+	"github.com/eris-ltd/eth-client/crypto"
+	"github.com/eris-ltd/eth-client/utils"
+)
+
+//---------------------------------------------------------------
+// the ethereum name service
+//
+// A name (eg. alice.eth) is hashed into a node. The ENS registry knows
+// the resolver contract of each node, and the resolver knows its address.
+// Reverse records map an address back to a name, under
+// <hex address>.addr.reverse, but anyone can claim any name there,
+// so a reverse name only counts if it resolves forward to the address.
+
+// ENS registries by chain id. The registry is deployed at the same
+// address on mainnet and the public testnets
+var Registries = map[uint64]string{
+	1:        "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e", // mainnet
+	5:        "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e", // goerli
+	17000:    "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e", // holesky
+	11155111: "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e", // sepolia
+}
+
+var (
+	node    = abi.Argument{Name: "node", Type: abi.Type{Kind: abi.FixedBytesKind, Size: 32}}
+	address = abi.Argument{Type: abi.Type{Kind: abi.AddressKind, Size: 20}}
+
+	resolverMethod = &abi.Method{Name: "resolver", Inputs: []abi.Argument{node}, Outputs: []abi.Argument{address}}
+	addrMethod     = &abi.Method{Name: "addr", Inputs: []abi.Argument{node}, Outputs: []abi.Argument{address}}
+	nameMethod     = &abi.Method{Name: "name", Inputs: []abi.Argument{node}, Outputs: []abi.Argument{{Type: abi.Type{Kind: abi.StringKind}}}}
+)
+
+// Whether an address argument is a name to resolve rather than hex
+func IsName(s string) bool {
+	return strings.Contains(s, ".") && !strings.HasPrefix(s, "0x")
+}
+
+// Normalize a name: names are case insensitive. Full unicode normalization
+// (ENSIP-15) isn't done, so only ascii names are taken, to avoid
+// resolving a lookalike of the name that was meant
+func Normalize(name string) (string, error) {
+	for _, c := range name {
+		if c > 0x7e || c <= 0x20 {
+			return "", fmt.Errorf("name %q has non-ascii characters or spaces, which aren't supported", name)
+		}
+	}
+	name = strings.ToLower(name)
+	for _, label := range strings.Split(name, ".") {
+		if label == "" {
+			return "", fmt.Errorf("name %q has an empty label", name)
+		}
+	}
+	return name, nil
+}
+
+// The namehash of a (normalized) name: keccak256(namehash(parent) . keccak256(label))
+func Namehash(name string) []byte {
+	node := make([]byte, 32)
+	if name == "" {
+		return node
+	}
+	labels := strings.Split(name, ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		node = crypto.Keccak256(node, crypto.Keccak256([]byte(labels[i])))
+	}
+	return node
+}
+
+// The registry to use: the given one, or the known one for the node's chain
+func Registry(client *utils.Client, registry string) (string, error) {
+	if registry != "" {
+		return registry, nil
+	}
+	r, err := client.RequestResponse("eth", "chainId")
+	if err != nil {
+		return "", fmt.Errorf("Error fetching chain id to find the ENS registry: %v", err)
+	}
+	s, _ := r.(string)
+	chainID := uint64(utils.HexToInt(s))
+	registry, ok := Registries[chainID]
+	if !ok {
+		return "", fmt.Errorf("no ENS registry is known for chain id %d (set it with --ens-registry)", chainID)
+	}
+	return registry, nil
+}
+
+// Resolve a name to an address, returning the resolver it came from too
+func Resolve(client *utils.Client, registry, name string) (addr, resolver []byte, err error) {
+	name, err = Normalize(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	node := Namehash(name)
+	if resolver, err = lookupResolver(client, registry, node); err != nil {
+		return nil, nil, err
+	}
+	if resolver == nil {
+		return nil, nil, fmt.Errorf("%s has no resolver", name)
+	}
+	v, err := call(client, resolver, addrMethod, node)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error resolving %s: %v", name, err)
+	}
+	addr = addressValue(v)
+	if addr == nil {
+		return nil, nil, fmt.Errorf("%s has no address", name)
+	}
+	return addr, resolver, nil
+}
+
+// The name of an address from its reverse record, if it resolves back
+// to the address ("" if there's no such name)
+func ReverseResolve(client *utils.Client, registry string, addr []byte) (string, error) {
+	node := Namehash(hex.EncodeToString(addr) + ".addr.reverse")
+	resolver, err := lookupResolver(client, registry, node)
+	if err != nil || resolver == nil {
+		return "", err
+	}
+	v, err := call(client, resolver, nameMethod, node)
+	if err != nil {
+		return "", fmt.Errorf("Error reverse resolving %s: %v", utils.ChecksumAddress(addr), err)
+	}
+	name, _ := v.(string)
+	if name == "" {
+		return "", nil
+	}
+	forward, _, err := Resolve(client, registry, name)
+	if err != nil || !bytes.Equal(forward, addr) {
+		// a claim, not a name
+		return "", nil
+	}
+	return name, nil
+}
+
+func lookupResolver(client *utils.Client, registry string, node []byte) ([]byte, error) {
+	reg, err := utils.ParseAddress(registry)
+	if err != nil {
+		return nil, fmt.Errorf("bad ENS registry: %v", err)
+	}
+	v, err := call(client, reg, resolverMethod, node)
+	if err != nil {
+		return nil, fmt.Errorf("Error looking up resolver in the ENS registry: %v", err)
+	}
+	return addressValue(v), nil
+}
+
+// eth_call a method taking a node and returning one value
+func call(client *utils.Client, to []byte, m *abi.Method, node []byte) (interface{}, error) {
+	ret, err := abi.CallRaw(client, to, m, utils.HexString(node))
+	if err != nil || len(ret) == 0 {
+		// no return means there's no contract
+		return nil, err
+	}
+	values, err := m.Unpack(ret)
+	if err != nil {
+		return nil, err
+	}
+	return values[0].Value, nil
+}
+
+// the address of a decoded value, or nil if it's unset
+func addressValue(v interface{}) []byte {
+	s, _ := v.(string)
+	addr, err := hex.DecodeString(utils.StripHex(s))
+	if err != nil || len(addr) != 20 || bytes.Equal(addr, make([]byte, 20)) {
+		return nil
+	}
+	return addr
+}
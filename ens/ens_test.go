@@ -0,0 +1,43 @@
+package ens
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// the examples from EIP-137
+func TestNamehash(t *testing.T) {
+	for _, test := range []struct {
+		name, hash string
+	}{
+		{"", "0000000000000000000000000000000000000000000000000000000000000000"},
+		{"eth", "93cdeb708b7545dc668eb9280176169d1c33cfd8ed6f04690a0bcc88a93fc4ae"},
+		{"foo.eth", "de9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f"},
+	} {
+		if got := hex.EncodeToString(Namehash(test.name)); got != test.hash {
+			t.Errorf("namehash of %q is %s, want %s", test.name, got, test.hash)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	for _, test := range []struct {
+		name, normalized string // "" means an error
+	}{
+		{"foo.eth", "foo.eth"},
+		{"Foo.ETH", "foo.eth"},
+		{"foo..eth", ""},
+		{"foo.eth.", ""},
+		{"fоо.eth", ""}, // cyrillic o's
+		{"foo bar.eth", ""},
+	} {
+		name, err := Normalize(test.name)
+		if test.normalized == "" {
+			if err == nil {
+				t.Errorf("%q normalized to %q", test.name, name)
+			}
+		} else if err != nil || name != test.normalized {
+			t.Errorf("%q normalized to %q (%v), want %q", test.name, name, err, test.normalized)
+		}
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/eris-ltd/eth-client/hdwallet"
+	"github.com/eris-ltd/eth-client/utils"
+
 	. "github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/eris-ltd/common/go/common"
 	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/spf13/cobra"
 )
@@ -39,6 +42,12 @@ type GenesisBlock struct {
 }
 
 func cliGen(cmd *cobra.Command, args []string) {
+	profile, err := utils.LoadProfile(ProfileFlag)
+	IfExit(err)
+	if profile != nil {
+		IfExit(profile.Apply(cmd.Flags()))
+	}
+
 	g := &GenesisBlock{
 		Nonce:      NonceFlag,
 		Timestamp:  Timestamp,
@@ -50,21 +59,30 @@ func cliGen(cmd *cobra.Command, args []string) {
 		Coinbase:   Coinbase,
 	}
 
-	if CsvPathFlag == "" && len(args) == 0 {
-		Exit(fmt.Errorf("Please pass addresses as arguments or use the --csv flag"))
+	if CsvPathFlag == "" && MnemonicFlag == "" && len(args) == 0 {
+		Exit(fmt.Errorf("Please pass addresses as arguments or use the --csv or --mnemonic flags"))
 	}
 
+	utils.SkipChecksum = SkipChecksumFlag
 	alloc := make(map[string]Account)
 
 	for _, a := range args {
-		alloc[a] = Account{DefaultBalance}
+		alloc[checksum(a)] = Account{DefaultBalance}
 	}
 
 	if CsvPathFlag != "" {
 		addrs, balances, err := parseCsv(CsvPathFlag)
 		IfExit(err)
 		for i, addr := range addrs {
-			alloc[addr] = Account{balances[i]}
+			alloc[checksum(addr)] = Account{balances[i]}
+		}
+	}
+
+	if MnemonicFlag != "" {
+		addrs, err := mnemonicAccounts(MnemonicFlag, HDPathFlag, CountFlag)
+		IfExit(err)
+		for _, addr := range addrs {
+			alloc[addr] = Account{DefaultBalance}
 		}
 	}
 
@@ -99,6 +117,44 @@ func parseCsv(filePath string) (addrs []string, balances []string, err error) {
 	return addrs, balances, nil
 }
 
+// the addresses of count accounts derived from the mnemonic in a file
+func mnemonicAccounts(filePath, hdPath string, count int) ([]string, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("--count must be at least 1")
+	}
+	mnemonic, err := hdwallet.LoadMnemonic(filePath)
+	if err != nil {
+		return nil, err
+	}
+	wallet, err := hdwallet.New(mnemonic, "")
+	if err != nil {
+		return nil, err
+	}
+	path, err := hdwallet.ParsePath(hdPath)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := wallet.Accounts(path, count)
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]string, len(accounts))
+	for i, account := range accounts {
+		addrs[i] = utils.ChecksumAddress(account.Address.Bytes())
+	}
+	return addrs, nil
+}
+
+// check an address (or a name in the profile's address book), and write it checksummed
+func checksum(addr string) string {
+	if a, ok := utils.LookupAddress(addr); ok {
+		addr = a
+	}
+	b, err := utils.ParseAddress(addr)
+	IfExit(err)
+	return utils.ChecksumAddress(b)
+}
+
 func ifExistsElse(list []string, index int, defaultValue string) string {
 	if len(list) > index {
 		if list[index] != "" {
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"github.com/eris-ltd/eth-client/hdwallet"
+
 	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/spf13/cobra"
 )
 
@@ -13,12 +15,20 @@ import (
 */
 
 var (
+	ProfileFlag string
+
 	CsvPathFlag string
 
+	MnemonicFlag string
+	HDPathFlag   string
+	CountFlag    int
+
 	NonceFlag      string
 	DifficultyFlag string
 	ExtraDataFlag  string
 	GasLimitFlag   string
+
+	SkipChecksumFlag bool
 )
 
 func main() {
@@ -28,11 +38,16 @@ func main() {
 		Long:  "a tool for generating ethereum genesis files",
 		Run:   cliGen,
 	}
+	rootCmd.Flags().StringVarP(&ProfileFlag, "profile", "", "", "profile of the config file (~/.eth-client/config.toml) to take the --mnemonic, --hd-path and address book from (default $ETHTX_PROFILE, or the config's own)")
 	rootCmd.Flags().StringVarP(&CsvPathFlag, "csv", "c", "", "path to .csv where each line is (address, balance)")
+	rootCmd.Flags().StringVarP(&MnemonicFlag, "mnemonic", "m", "", "file containing a BIP-39 mnemonic, to prefund --count of its accounts (as derived by ethtx accounts derive)")
+	rootCmd.Flags().StringVarP(&HDPathFlag, "hd-path", "", hdwallet.DefaultPath, "BIP-32 path of the first --mnemonic account (the last index counts up)")
+	rootCmd.Flags().IntVarP(&CountFlag, "count", "", 1, "number of --mnemonic accounts to prefund")
 
 	rootCmd.Flags().StringVarP(&NonceFlag, "nonce", "n", "0x0000000000000042", "genesis nonce")
 	rootCmd.Flags().StringVarP(&DifficultyFlag, "difficulty", "d", "0x0fffff", "starting mining difficulty")
 	rootCmd.Flags().StringVarP(&ExtraDataFlag, "extra-data", "x", "", "extra data for the genesis block")
 	rootCmd.Flags().StringVarP(&GasLimitFlag, "gas-limit", "g", "0xffffffffffffffff", "starting gas limit per block")
+	rootCmd.Flags().BoolVarP(&SkipChecksumFlag, "skip-checksum", "", false, "accept mixed case addresses with bad EIP-55 checksums")
 	rootCmd.Execute()
 }
@@ -1,10 +1,23 @@
 package main
 
 import (
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
 	"sort"
-
+	"strings"
+
+	"github.com/eris-ltd/eth-client/abi"
+	"github.com/eris-ltd/eth-client/crypto"
+	"github.com/eris-ltd/eth-client/eip712"
+	"github.com/eris-ltd/eth-client/ens"
+	"github.com/eris-ltd/eth-client/namereg"
+	"github.com/eris-ltd/eth-client/nft"
+	"github.com/eris-ltd/eth-client/token"
 	"github.com/eris-ltd/eth-client/utils"
 
 	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/eris-ltd/common/go/common"
@@ -87,7 +100,8 @@ type Account struct {
 	Nonce       uint64 `json:"nonce"`
 	Balance     string `json:"balance"`
 	Code        string `json:"code"`
-	StorageHash string `json:"storage_hash"` // not sure this is supported but a storage map is (TODO)
+	StorageHash string `json:"storage_hash"`   // not sure this is supported but a storage map is (TODO)
+	Name        string `json:"name,omitempty"` // ENS reverse record
 }
 
 func cliAccount(cmd *cobra.Command, args []string) {
@@ -95,9 +109,10 @@ func cliAccount(cmd *cobra.Command, args []string) {
 		common.Exit(fmt.Errorf("must specify an account"))
 	}
 
-	addr := args[0]
+	addr := resolveAddr(args[0])
 	acc := new(Account)
 	acc.Address = addr
+	acc.Name = reverseName(addr)
 
 	r, err := client.RequestResponse("eth", "blockNumber")
 	common.IfExit(err)
@@ -106,6 +121,10 @@ func cliAccount(cmd *cobra.Command, args []string) {
 	r, err = client.RequestResponse("eth", "getBalance", addr, blockNum)
 	common.IfExit(err)
 	acc.Balance = r.(string)
+	if UnitsFlag != "" {
+		acc.Balance, err = formatBalance(acc.Balance, UnitsFlag)
+		common.IfExit(err)
+	}
 
 	r, err = client.RequestResponse("eth", "getTransactionCount", addr, blockNum)
 	common.IfExit(err)
@@ -120,6 +139,19 @@ func cliAccount(cmd *cobra.Command, args []string) {
 	fmt.Println(string(b))
 }
 
+// render a hex balance in the given units, eg. "1.5 ether"
+func formatBalance(balance, units string) (string, error) {
+	decimals, err := utils.UnitDecimals(units)
+	if err != nil {
+		return "", err
+	}
+	x, err := utils.ParseUnits(balance)
+	if err != nil {
+		return "", fmt.Errorf("node returned bad balance %s: %v", balance, err)
+	}
+	return fmt.Sprintf("%s %s", utils.FormatUnits(x, decimals), strings.ToLower(units)), nil
+}
+
 //---------------------------------------------------------------
 // ethinfo storage
 
@@ -128,7 +160,7 @@ func cliStorage(cmd *cobra.Command, args []string) {
 		common.Exit(fmt.Errorf("must specify an account"))
 	}
 
-	addr := args[0]
+	addr := resolveAddr(args[0])
 	var storageKey string
 	if len(args) > 1 {
 		storageKey = args[1]
@@ -163,7 +195,23 @@ func cliReceipt(cmd *cobra.Command, args []string) {
 
 	r, err := client.RequestResponse("eth", "getTransactionReceipt", txHash)
 	common.IfExit(err)
-	sortPrintMap(r.(map[string]interface{}))
+	receipt, ok := r.(map[string]interface{})
+	if !ok {
+		common.Exit(fmt.Errorf("no receipt for %s (is the tx mined?)", txHash))
+	}
+	// checksum the addresses and annotate them with their ENS names
+	for _, k := range []string{"from", "to", "contractAddress"} {
+		addrS, _ := receipt[k].(string)
+		addr, err := utils.ParseAddress(addrS)
+		if err != nil {
+			continue
+		}
+		receipt[k] = utils.ChecksumAddress(addr)
+		if name := reverseName(addrS); name != "" {
+			receipt[k] = fmt.Sprintf("%s (%s)", receipt[k], name)
+		}
+	}
+	sortPrintMap(receipt)
 
 }
 
@@ -186,12 +234,31 @@ func cliBroadcast(cmd *cobra.Command, args []string) {
 // ethinfo estimate
 
 type callData struct {
-	From     string
-	To       string
-	Value    interface{}
-	Gas      interface{}
-	GasPrice interface{}
-	Data     string
+	From     string `json:"from,omitempty"`
+	To       string `json:"to,omitempty"`
+	Value    string `json:"value,omitempty"`
+	Gas      string `json:"gas,omitempty"`
+	GasPrice string `json:"gasPrice,omitempty"`
+	Data     string `json:"data,omitempty"`
+}
+
+// the node takes hex quantities, and we take units (eg. --amt=1.5ether)
+func newCallData(data string) (*callData, error) {
+	c := &callData{From: FromFlag, To: ToFlag, Data: data}
+	for _, q := range []struct {
+		name, s string
+		dst     *string
+	}{{"amt", AmtFlag, &c.Value}, {"gas", GasFlag, &c.Gas}, {"price", PriceFlag, &c.GasPrice}} {
+		if q.s == "" {
+			continue
+		}
+		x, err := utils.ParseUnits(q.s)
+		if err != nil {
+			return nil, fmt.Errorf("bad %s: %v", q.name, err)
+		}
+		*q.dst = fmt.Sprintf("0x%x", x)
+	}
+	return c, nil
 }
 
 func cliEstimate(cmd *cobra.Command, args []string) {
@@ -199,7 +266,8 @@ func cliEstimate(cmd *cobra.Command, args []string) {
 	common.IfExit(err)
 	blockNum := utils.HexToInt(r.(string))
 
-	callArgs := callData{FromFlag, ToFlag, AmtFlag, GasFlag, PriceFlag, DataFlag}
+	callArgs, err := newCallData(DataFlag)
+	common.IfExit(err)
 
 	r, err = client.RequestResponse("eth", "estimateGas", callArgs, blockNum)
 	common.IfExit(err)
@@ -210,15 +278,31 @@ func cliEstimate(cmd *cobra.Command, args []string) {
 // ethinfo call
 
 func cliCall(cmd *cobra.Command, args []string) {
-	r, err := client.RequestResponse("eth", "blockNumber")
+	data, contract, method, err := abi.CallData(DataFlag, AbiFlag, MethodFlag, args)
 	common.IfExit(err)
-	blockNum := utils.HexToInt(r.(string))
-
-	callArgs := callData{FromFlag, ToFlag, AmtFlag, GasFlag, PriceFlag, DataFlag}
+	callArgs, err := newCallData(data)
+	common.IfExit(err)
+	r, err := client.RequestResponse("eth", "call", callArgs, "latest")
+	if rpcErr, ok := err.(*utils.RPCError); ok {
+		// reverts come back as errors with the revert data
+		if revertData, ok := rpcErr.DataBytes(); ok {
+			common.Exit(fmt.Errorf("Call reverted: %s", abi.DecodeRevert(revertData, contract)))
+		}
+	}
+	common.IfExit(err)
+	if method == nil || len(method.Outputs) == 0 {
+		fmt.Println(r)
+		return
+	}
 
-	r, err = client.RequestResponse("eth", "call", callArgs, blockNum)
+	ret, _ := r.(string)
+	retBytes, err := hex.DecodeString(utils.StripHex(ret))
 	common.IfExit(err)
-	fmt.Println(r)
+	values, err := method.Unpack(retBytes)
+	common.IfExit(err)
+	b, err := json.MarshalIndent(values, "", "\t")
+	common.IfExit(err)
+	fmt.Println(string(b))
 }
 
 //---------------------------------------------------------------
@@ -227,6 +311,269 @@ func cliCall(cmd *cobra.Command, args []string) {
 func cliBlocks(cmd *cobra.Command, args []string) {
 }
 
+//---------------------------------------------------------------
+// ethinfo name
+
+func cliNameResolve(cmd *cobra.Command, args []string) {
+	if len(args) == 0 {
+		common.Exit(fmt.Errorf("must specify a name"))
+	}
+	name := args[0]
+	owner, err := namereg.Owner(client, RegistrarFlag, name)
+	common.IfExit(err)
+	if owner == nil {
+		common.Exit(fmt.Errorf("%s is not reserved", name))
+	}
+	addr, err := namereg.Resolve(client, RegistrarFlag, name)
+	common.IfExit(err)
+	if addr == nil {
+		common.Exit(fmt.Errorf("%s is owned by %s but doesn't point to an address", name, utils.ChecksumAddress(owner)))
+	}
+	fmt.Printf("Address: %s\n", utils.ChecksumAddress(addr))
+	fmt.Printf("Owner: %s\n", utils.ChecksumAddress(owner))
+}
+
+//---------------------------------------------------------------
+// ethinfo token
+
+func cliTokenInfo(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		common.Exit(fmt.Errorf("must specify a token"))
+	}
+	tokenAddr := tokenArg(args[0])
+	info, err := token.GetInfo(client, tokenAddr)
+	common.IfExit(err)
+	fmt.Printf("Name: %s\n", info.Name)
+	fmt.Printf("Symbol: %s\n", info.Symbol)
+	if info.Decimals < 0 {
+		// amounts are whole numbers of the token
+		fmt.Println("Decimals: none")
+		info.Decimals = 0
+	} else {
+		fmt.Printf("Decimals: %d\n", info.Decimals)
+	}
+	fmt.Printf("Total Supply: %s\n", token.Format(info.TotalSupply, info.Decimals, info.Symbol))
+}
+
+func cliTokenBalance(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		common.Exit(fmt.Errorf("must specify a token and an address"))
+	}
+	tokenAddr := tokenArg(args[0])
+	owner, err := utils.ParseAddress(resolveAddr(args[1]))
+	common.IfExit(err)
+	balance, err := token.BalanceOf(client, tokenAddr, owner)
+	common.IfExit(err)
+	fmt.Printf("Balance: %s\n", formatTokens(tokenAddr, balance))
+}
+
+func cliTokenAllowance(cmd *cobra.Command, args []string) {
+	if len(args) != 3 {
+		common.Exit(fmt.Errorf("must specify a token, an owner and a spender"))
+	}
+	tokenAddr := tokenArg(args[0])
+	owner, err := utils.ParseAddress(resolveAddr(args[1]))
+	common.IfExit(err)
+	spender, err := utils.ParseAddress(resolveAddr(args[2]))
+	common.IfExit(err)
+	allowance, err := token.Allowance(client, tokenAddr, owner, spender)
+	common.IfExit(err)
+	if allowance.Cmp(token.MaxAmount) == 0 {
+		fmt.Println("Allowance: unlimited")
+		return
+	}
+	fmt.Printf("Allowance: %s\n", formatTokens(tokenAddr, allowance))
+}
+
+func tokenArg(s string) []byte {
+	addr, err := utils.ParseAddress(resolveAddr(s))
+	common.IfExit(err)
+	return addr
+}
+
+// an amount in the token's decimals (or as is, if it has none)
+func formatTokens(tokenAddr []byte, amount *big.Int) string {
+	decimals, err := token.Decimals(client, tokenAddr)
+	if err != nil {
+		decimals = 0
+	}
+	return token.Format(amount, decimals, token.Symbol(client, tokenAddr))
+}
+
+//---------------------------------------------------------------
+// ethinfo nft
+
+func cliNFTOwner(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		common.Exit(fmt.Errorf("must specify a contract and a token id"))
+	}
+	contract, std, id := nftArgs(args[0], args[1])
+	owner, err := nft.OwnerOf(client, contract, std, id)
+	common.IfExit(err)
+	fmt.Printf("Owner: %s\n", owner)
+}
+
+func cliNFTTokenURI(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		common.Exit(fmt.Errorf("must specify a contract and a token id"))
+	}
+	contract, std, id := nftArgs(args[0], args[1])
+	uri, err := nft.TokenURI(client, contract, std, id)
+	common.IfExit(err)
+	fmt.Printf("URI: %s\n", uri)
+}
+
+func cliNFTBalance(cmd *cobra.Command, args []string) {
+	if len(args) != 2 && len(args) != 3 {
+		common.Exit(fmt.Errorf("must specify a contract and an address, and a token id for ERC-1155"))
+	}
+	owner, err := utils.ParseAddress(resolveAddr(args[1]))
+	common.IfExit(err)
+	var contract []byte
+	var std nft.Standard
+	var id *big.Int
+	if len(args) == 3 {
+		contract, std, id = nftArgs(args[0], args[2])
+	} else {
+		contract = tokenArg(args[0])
+		std = detectNFT(contract)
+	}
+	balance, err := nft.BalanceOf(client, contract, std, owner, id)
+	common.IfExit(err)
+	fmt.Printf("Balance: %v\n", balance)
+}
+
+// the contract, its standard, and the token id
+func nftArgs(contractArg, idArg string) ([]byte, nft.Standard, *big.Int) {
+	id, err := nft.ParseID(idArg)
+	common.IfExit(err)
+	contract := tokenArg(contractArg)
+	return contract, detectNFT(contract), id
+}
+
+func detectNFT(contract []byte) nft.Standard {
+	std, err := nft.Detect(client, contract)
+	common.IfExit(err)
+	return std
+}
+
+//---------------------------------------------------------------
+// ethinfo create2-address
+
+func cliCreate2Address(cmd *cobra.Command, args []string) {
+	if len(args) < 2 {
+		common.Exit(fmt.Errorf("must specify a salt and the contract's code"))
+	}
+	salt, err := crypto.ParseSalt(args[0])
+	common.IfExit(err)
+	factory, err := utils.ParseAddress(resolveAddr(FactoryFlag))
+	common.IfExit(err)
+	initCode, err := abi.InitCode(args[1], AbiFlag, nil, args[2:])
+	common.IfExit(err)
+	fmt.Printf("Init Code Hash: 0x%x\n", crypto.Keccak256(initCode))
+	fmt.Printf("Address: %s\n", utils.ChecksumAddress(crypto.Create2Address(factory, salt, initCode)))
+}
+
+//---------------------------------------------------------------
+// ethinfo verify-signature
+
+func cliVerifySignature(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		common.Exit(fmt.Errorf("must specify a signature and the message (or a file of it, or - to read it from stdin)"))
+	}
+	sigBytes, err := hex.DecodeString(utils.StripHex(args[0]))
+	if err != nil {
+		common.Exit(fmt.Errorf("signature is bad hex: %v", err))
+	}
+	sig, err := crypto.ParseMessageSignature(sigBytes)
+	common.IfExit(err)
+
+	msg := []byte(args[1])
+	if args[1] == "-" {
+		msg, err = ioutil.ReadAll(os.Stdin)
+	} else if _, statErr := os.Stat(args[1]); statErr == nil {
+		msg, err = ioutil.ReadFile(args[1])
+	}
+	common.IfExit(err)
+
+	var hash []byte
+	switch {
+	case TypedDataFlag:
+		td, err := eip712.Parse(msg)
+		common.IfExit(err)
+		hash, err = td.Hash()
+		common.IfExit(err)
+	case HexFlag:
+		msg, err = hex.DecodeString(utils.StripHex(strings.TrimSpace(string(msg))))
+		common.IfExit(err)
+		hash = crypto.TextHash(msg)
+	default:
+		hash = crypto.TextHash(msg)
+	}
+
+	signer, err := crypto.RecoverAddress(hash, sig)
+	common.IfExit(err)
+	fmt.Printf("Hash: 0x%x\n", hash)
+	fmt.Printf("Signer: %s\n", utils.ChecksumAddress(signer.Bytes()))
+	if SignerFlag != "" {
+		expected, err := utils.ParseAddress(resolveAddr(SignerFlag))
+		common.IfExit(err)
+		if !bytes.Equal(expected, signer.Bytes()) {
+			common.Exit(fmt.Errorf("signature is not from %s", utils.ChecksumAddress(expected)))
+		}
+		fmt.Println("Valid: true")
+	}
+}
+
+//---------------------------------------------------------------
+// ens
+
+// check an address argument, or resolve it if it's a name in the profile's address book
+// or an ENS name (saying what it resolved to on stderr), and return it checksummed
+func resolveAddr(s string) string {
+	if addr, ok := utils.LookupAddress(s); ok {
+		s = addr
+	}
+	if !ens.IsName(s) {
+		addr, err := utils.ParseAddress(s)
+		common.IfExit(err)
+		return utils.ChecksumAddress(addr)
+	}
+	registry, err := ens.Registry(client, ENSRegistryFlag)
+	common.IfExit(err)
+	addr, resolver, err := ens.Resolve(client, registry, s)
+	common.IfExit(err)
+	fmt.Fprintf(os.Stderr, "Resolved %s to %s (resolver %s)\n", s, utils.ChecksumAddress(addr), utils.ChecksumAddress(resolver))
+	return utils.ChecksumAddress(addr)
+}
+
+// resolve a name given for an address arg of a method, saying what it resolved to
+func resolveArg(s string) (string, error) {
+	addr := resolveAddr(s)
+	if _, ok := utils.LookupAddress(s); ok {
+		fmt.Fprintf(os.Stderr, "Resolved %s to %s (address book)\n", s, addr)
+	}
+	return addr, nil
+}
+
+// the verified ENS name of an address, or "" if it has none
+// (or the chain has no known registry)
+func reverseName(addrS string) string {
+	addr, err := hex.DecodeString(utils.StripHex(addrS))
+	if err != nil || len(addr) != 20 {
+		return ""
+	}
+	registry, err := ens.Registry(client, ENSRegistryFlag)
+	if err != nil {
+		return ""
+	}
+	name, err := ens.ReverseResolve(client, registry, addr)
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
 //---------------------------------------------------------------
 // utils
 
@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/eris-ltd/eth-client/abi"
+	"github.com/eris-ltd/eth-client/crypto"
 	"github.com/eris-ltd/eth-client/utils"
 
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/eris-ltd/common/go/common"
 	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/spf13/cobra"
 )
 
@@ -14,6 +17,10 @@ var (
 	HOST_PORT = "8545"
 	HOST      = fmt.Sprintf("%s:%s", HOST_IP, HOST_PORT)
 
+	REGISTRAR = ""
+
+	ENS_REGISTRY = "" // by chain id if not given
+
 	client *utils.Client
 )
 
@@ -23,10 +30,26 @@ func init() {
 	if nodeAddr != "" {
 		HOST = nodeAddr
 	}
+
+	registrar := os.Getenv("ETHTX_REGISTRAR")
+	if registrar != "" {
+		REGISTRAR = registrar
+	}
+
+	ensRegistry := os.Getenv("ETHTX_ENS_REGISTRY")
+	if ensRegistry != "" {
+		ENS_REGISTRY = ensRegistry
+	}
 }
 
 var (
-	HostAddrFlag string
+	ProfileFlag      string
+	HostAddrFlag     string
+	ENSRegistryFlag  string
+	SkipChecksumFlag bool
+
+	// flags for `account`
+	UnitsFlag string
 
 	// flags for `call` and `estimate`
 	ToFlag    string
@@ -35,6 +58,21 @@ var (
 	GasFlag   string
 	PriceFlag string
 	DataFlag  string
+
+	// abi encoded calls
+	AbiFlag    string
+	MethodFlag string
+
+	// flags for `name`
+	RegistrarFlag string
+
+	// flags for `create2-address`
+	FactoryFlag string
+
+	// flags for `verify-signature`
+	TypedDataFlag bool
+	HexFlag       bool
+	SignerFlag    string
 )
 
 func main() {
@@ -56,14 +94,15 @@ func main() {
 
 	var accountCmd = &cobra.Command{
 		Use:   "account",
-		Short: "ethinfo account <address>",
-		Long:  "print an account",
+		Short: "ethinfo account <address or ENS name>",
+		Long:  "print an account, and its ENS name if it has one",
 		Run:   cliAccount,
 	}
+	accountCmd.Flags().StringVarP(&UnitsFlag, "units", "u", "", "show the balance in these units (eg. ether, gwei or wei) instead of hex")
 
 	var storageCmd = &cobra.Command{
 		Use:   "storage",
-		Short: "ethinfo storage <address> [storage key]",
+		Short: "ethinfo storage <address or ENS name> [storage key]",
 		Long:  "print an account's storage or a single storage key",
 		Run:   cliStorage,
 	}
@@ -88,25 +127,27 @@ func main() {
 		Long:  "estimate the gas required to run a transaction",
 		Run:   cliEstimate,
 	}
-	estimateCmd.Flags().StringVarP(&ToFlag, "to", "t", "", "contract to call")
-	estimateCmd.Flags().StringVarP(&FromFlag, "from", "f", "", "address to send from")
-	estimateCmd.Flags().StringVarP(&AmtFlag, "amt", "a", "", "amt to send")
+	estimateCmd.Flags().StringVarP(&ToFlag, "to", "t", "", "contract to call (an address or ENS name)")
+	estimateCmd.Flags().StringVarP(&FromFlag, "from", "f", "", "address (or ENS name) to send from")
+	estimateCmd.Flags().StringVarP(&AmtFlag, "amt", "a", "", "amt to send (eg. 100, 0x64, 1.5ether, 20gwei)")
 	estimateCmd.Flags().StringVarP(&GasFlag, "gas", "g", "", "gas to allocate for the call")
-	estimateCmd.Flags().StringVarP(&PriceFlag, "price", "p", "", "price per unit of gas")
+	estimateCmd.Flags().StringVarP(&PriceFlag, "price", "p", "", "price per unit of gas (eg. 20gwei)")
 	estimateCmd.Flags().StringVarP(&DataFlag, "data", "d", "", "data to send the contract")
 
 	var callCmd = &cobra.Command{
 		Use:   "call",
-		Short: "ethinfo call [flags]",
-		Long:  "simulate calling a contract",
+		Short: "ethinfo call [flags] [args...]",
+		Long:  "simulate calling a contract, with raw --data or args encoded with a json abi",
 		Run:   cliCall,
 	}
-	callCmd.Flags().StringVarP(&ToFlag, "to", "t", "", "contract to call")
-	callCmd.Flags().StringVarP(&FromFlag, "from", "f", "", "address to send from")
-	callCmd.Flags().StringVarP(&AmtFlag, "amt", "a", "", "amt to send")
+	callCmd.Flags().StringVarP(&ToFlag, "to", "t", "", "contract to call (an address or ENS name)")
+	callCmd.Flags().StringVarP(&FromFlag, "from", "f", "", "address (or ENS name) to send from")
+	callCmd.Flags().StringVarP(&AmtFlag, "amt", "a", "", "amt to send (eg. 100, 0x64, 1.5ether, 20gwei)")
 	callCmd.Flags().StringVarP(&GasFlag, "gas", "g", "", "gas to allocate for the call")
-	callCmd.Flags().StringVarP(&PriceFlag, "price", "p", "", "price per unit of gas")
+	callCmd.Flags().StringVarP(&PriceFlag, "price", "p", "", "price per unit of gas (eg. 20gwei)")
 	callCmd.Flags().StringVarP(&DataFlag, "data", "d", "", "data to send the contract")
+	callCmd.Flags().StringVarP(&AbiFlag, "abi", "", "", "json abi (or compiler artifact) of the contract, to encode --method and its args")
+	callCmd.Flags().StringVarP(&MethodFlag, "method", "", "", "function to call, by name or full signature if overloaded (eg. 'balanceOf(address)')")
 
 	var blocksCmd = &cobra.Command{
 		Use:   "block",
@@ -115,12 +156,105 @@ func main() {
 		Run:   cliBlocks,
 	}
 
+	var nameCmd = &cobra.Command{
+		Use:   "name",
+		Short: "look up names in the global name registrar",
+		Long:  "look up names in the global name registrar",
+	}
+
+	var nameResolveCmd = &cobra.Command{
+		Use:   "resolve",
+		Short: "ethinfo name resolve <name>",
+		Long:  "print the address a name points to, and its owner",
+		Run:   cliNameResolve,
+	}
+	nameCmd.AddCommand(nameResolveCmd)
+	nameCmd.PersistentFlags().StringVarP(&RegistrarFlag, "registrar", "", REGISTRAR, "address of the global name registrar contract")
+
+	var tokenCmd = &cobra.Command{
+		Use:   "token",
+		Short: "look up ERC-20 tokens",
+		Long:  "look up ERC-20 tokens, their balances and allowances, with amounts in the token's decimals",
+	}
+
+	var tokenInfoCmd = &cobra.Command{
+		Use:   "info",
+		Short: "ethinfo token info <token>",
+		Long:  "show a token's name, symbol, decimals and total supply",
+		Run:   cliTokenInfo,
+	}
+
+	var tokenBalanceCmd = &cobra.Command{
+		Use:   "balance",
+		Short: "ethinfo token balance <token> <address>",
+		Long:  "show the token balance of an address",
+		Run:   cliTokenBalance,
+	}
+
+	var tokenAllowanceCmd = &cobra.Command{
+		Use:   "allowance",
+		Short: "ethinfo token allowance <token> <owner> <spender>",
+		Long:  "show how many of owner's tokens spender may still send",
+		Run:   cliTokenAllowance,
+	}
+	tokenCmd.AddCommand(tokenInfoCmd, tokenBalanceCmd, tokenAllowanceCmd)
+
+	var nftCmd = &cobra.Command{
+		Use:   "nft",
+		Short: "look up ERC-721 and ERC-1155 tokens",
+		Long:  "look up the owners, balances and metadata of NFTs, for either standard (told apart with ERC-165)",
+	}
+
+	var nftOwnerCmd = &cobra.Command{
+		Use:   "owner",
+		Short: "ethinfo nft owner <contract> <id>",
+		Long:  "show the owner of an ERC-721 token",
+		Run:   cliNFTOwner,
+	}
+
+	var nftTokenURICmd = &cobra.Command{
+		Use:   "tokenURI",
+		Short: "ethinfo nft tokenURI <contract> <id>",
+		Long:  "show the URI of a token's metadata (for ERC-1155, with {id} filled in)",
+		Run:   cliNFTTokenURI,
+	}
+
+	var nftBalanceCmd = &cobra.Command{
+		Use:   "balance",
+		Short: "ethinfo nft balance <contract> <address> [id]",
+		Long:  "show how many of the contract's tokens an address has (ERC-721), or how many of the token with the id (ERC-1155)",
+		Run:   cliNFTBalance,
+	}
+	nftCmd.AddCommand(nftOwnerCmd, nftTokenURICmd, nftBalanceCmd)
+
+	var create2AddressCmd = &cobra.Command{
+		Use:   "create2-address",
+		Short: "ethinfo create2-address <salt> <code | file> [--abi <abi> args...]",
+		Long:  "show the address a contract will have when deployed with CREATE2 by --factory with the salt (see ethtx create --create2)",
+		Run:   cliCreate2Address,
+	}
+	create2AddressCmd.Flags().StringVarP(&FactoryFlag, "factory", "", crypto.DeploymentProxy, "the deployer: the factory contract that runs CREATE2 (default the deterministic deployment proxy)")
+	create2AddressCmd.Flags().StringVarP(&AbiFlag, "abi", "", "", "json abi (or compiler artifact) of the contract, to encode the constructor args (default the code's, if it's an artifact)")
+
+	var verifySignatureCmd = &cobra.Command{
+		Use:   "verify-signature",
+		Short: "ethinfo verify-signature <signature> <message | file | ->",
+		Long:  "recover the address that signed a message (EIP-191) or typed data (EIP-712, with --typed-data)",
+		Run:   cliVerifySignature,
+	}
+	verifySignatureCmd.Flags().BoolVarP(&TypedDataFlag, "typed-data", "", false, "the message is EIP-712 typed data json")
+	verifySignatureCmd.Flags().BoolVarP(&HexFlag, "hex", "", false, "the message is hex encoded bytes, not text")
+	verifySignatureCmd.Flags().StringVarP(&SignerFlag, "signer", "", "", "fail unless the signature is from this address (or ENS name)")
+
 	var rootCmd = &cobra.Command{
 		Use:   "ethinfo",
 		Short: "a tool for talking to ethereum chains",
 		Long:  "a tool for talking to ethereum chains",
 	}
+	rootCmd.PersistentFlags().StringVarP(&ProfileFlag, "profile", "", "", "profile of the config file (~/.eth-client/config.toml) to take defaults from (default $ETHTX_PROFILE, or the config's own)")
 	rootCmd.PersistentFlags().StringVarP(&HostAddrFlag, "node-addr", "", HOST, "<ip>:<port> of the node we're talking to")
+	rootCmd.PersistentFlags().BoolVarP(&SkipChecksumFlag, "skip-checksum", "", false, "accept mixed case addresses with bad EIP-55 checksums")
+	rootCmd.PersistentFlags().StringVarP(&ENSRegistryFlag, "ens-registry", "", ENS_REGISTRY, "ENS registry to resolve names with (default the known one for the node's chain)")
 
 	rootCmd.PersistentPreRun = before
 
@@ -133,12 +267,31 @@ func main() {
 		receiptCmd,
 		estimateCmd,
 		callCmd,
-		blocksCmd)
+		blocksCmd,
+		nameCmd,
+		tokenCmd,
+		nftCmd,
+		create2AddressCmd,
+		verifySignatureCmd)
 	rootCmd.Execute()
 }
 
 func before(cmd *cobra.Command, args []string) {
+	profile, err := utils.LoadProfile(ProfileFlag)
+	common.IfExit(err)
+	if profile != nil {
+		common.IfExit(profile.Apply(cmd.Flags()))
+	}
+
 	HostAddrFlag = "http://" + HostAddrFlag
 	client = utils.NewClient(HostAddrFlag)
 
+	utils.SkipChecksum = SkipChecksumFlag
+	if ToFlag != "" {
+		ToFlag = resolveAddr(ToFlag)
+	}
+	if FromFlag != "" {
+		FromFlag = resolveAddr(FromFlag)
+	}
+	abi.ResolveAddress = resolveArg
 }
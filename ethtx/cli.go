@@ -1,19 +1,135 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/abi"
+	"github.com/eris-ltd/eth-client/crypto"
+	"github.com/eris-ltd/eth-client/eip712"
+	"github.com/eris-ltd/eth-client/ens"
 	"github.com/eris-ltd/eth-client/ethtx/core"
+	"github.com/eris-ltd/eth-client/hdwallet"
+	"github.com/eris-ltd/eth-client/namereg"
+	"github.com/eris-ltd/eth-client/nft"
+	"github.com/eris-ltd/eth-client/token"
+	"github.com/eris-ltd/eth-client/utils"
 
 	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/eris-ltd/common/go/common"
+	ethcommon "github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
 
 	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/spf13/cobra"
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/golang.org/x/crypto/ssh/terminal"
 )
 
 func cliSend(cmd *cobra.Command, args []string) {
 	tx, err := core.Send(AddressFlag, ToFlag, AmtFlag, GasFlag, GasPriceFlag, NonceFlag)
 	common.IfExit(err)
+	processTx(tx)
+}
+
+func cliCreate(cmd *cobra.Command, args []string) {
+	code, err := initCode(args)
+	common.IfExit(err)
+	if Create2Flag {
+		create2(code)
+		return
+	}
+	if SaltFlag != "" || FactoryFlag != crypto.DeploymentProxy {
+		common.Exit(fmt.Errorf("--salt and --factory are only used with --create2"))
+	}
+	tx, err := core.Create(AddressFlag, AmtFlag, GasFlag, GasPriceFlag, code, NonceFlag)
+	common.IfExit(err)
+	processTx(tx)
+}
+
+// deploy the code through the factory, and make sure
+// it ends up at the predicted address
+func create2(code string) {
+	if SaltFlag == "" {
+		common.Exit(fmt.Errorf("--salt must be given with --create2"))
+	}
+	salt, err := crypto.ParseSalt(SaltFlag)
+	common.IfExit(err)
+	factory, err := utils.ParseAddress(resolveAddr(FactoryFlag))
+	common.IfExit(err)
+	initCode, err := hex.DecodeString(utils.StripHex(code))
+	common.IfExit(err)
+	tx, addr, err := core.Create2(AddressFlag, utils.ChecksumAddress(factory), AmtFlag, GasFlag, GasPriceFlag, salt, initCode, NonceFlag)
+	common.IfExit(err)
+	logger.Printf("Factory:   %s (salt 0x%x)\n", utils.ChecksumAddress(factory), salt)
+	logger.Printf("Predicted: %s\n", utils.ChecksumAddress(addr))
+	create2Address = addr
+	checkReturn = func(ret []byte) error {
+		return core.CheckCreate2Return(addr, ret)
+	}
+	processTx(tx)
+}
+
+// the linked code from --code, followed by the constructor args encoded with --abi
+func initCode(args []string) (string, error) {
+	if DataFlag == "" {
+		return "", fmt.Errorf("--code must be given")
+	}
+	code, err := abi.InitCode(DataFlag, AbiFlag, LinkFlags, args)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("0x%x", code), nil
+}
+
+func cliCall(cmd *cobra.Command, args []string) {
+	data, _, _, err := abi.CallData(DataFlag, AbiFlag, MethodFlag, args)
+	common.IfExit(err)
+	tx, err := core.Call(AddressFlag, ToFlag, AmtFlag, GasFlag, GasPriceFlag, data, NonceFlag)
+	common.IfExit(err)
+	processTx(tx)
+}
+
+// set the chain id and tx type on a freshly crafted tx,
+// then sign, broadcast and print it as requested
+func processTx(legacyTx *core.Transaction) {
+	ifExitRelease(setChainID(legacyTx), legacyTx)
+	tx, err := core.Typed(legacyTx, TxTypeFlag, PriorityFeeFlag, MaxFeeFlag, AccessListFlag)
+	ifExitRelease(err, legacyTx)
+	ifExitRelease(fillGas(tx), legacyTx)
 	logger.Infoln(tx)
-	r, err := core.SignAndBroadcast(SignAddrFlag, tx, SignFlag, BroadcastFlag, WaitFlag)
+	if BundleFlag != "" {
+		ifExitRelease(addToBundle(tx), legacyTx)
+		if jsonOut != nil {
+			common.IfExit(printJSON(tx, nil))
+		}
+		return
+	}
+	ifExitRelease(simulate(tx, "pending"), legacyTx)
+	r, err := core.SignAndBroadcast(signer, tx, SignFlag, BroadcastFlag, WaitFlag)
+	if r != nil {
+		// it was broadcast, even if waiting failed
+		nonceUsed(legacyTx)
+	} else {
+		ifExitRelease(err, legacyTx)
+	}
+	if err != nil && jsonOut != nil {
+		// scripts still need the hash of what was broadcast
+		printJSON(tx, r)
+	}
 	common.IfExit(err)
+	logSigned(tx, r)
+}
+
+// print the tx and its result, as requested
+func logSigned(tx core.Tx, r *core.TxResult) {
+	if jsonOut != nil {
+		common.IfExit(printJSON(tx, r))
+	}
 	if BinaryFlag {
 		logger.Printf("%X\n", tx.Bytes())
 	}
@@ -21,45 +137,1034 @@ func cliSend(cmd *cobra.Command, args []string) {
 		logger.Infof("Signature: %X\n", tx.Signature())
 	}
 	if BroadcastFlag {
-		logger.Println("TxID:", r)
+		logTxResult(r)
 	}
 }
 
-func cliCreate(cmd *cobra.Command, args []string) {
-	tx, err := core.Create(AddressFlag, AmtFlag, GasFlag, GasPriceFlag, DataFlag, NonceFlag)
+// what --output=json prints for a tx
+type txOutput struct {
+	Hash            string         `json:"hash,omitempty"` // once signed
+	Raw             string         `json:"raw"`            // serialized, signed or not
+	Signature       string         `json:"signature,omitempty"`
+	Type            string         `json:"type"`
+	ChainID         string         `json:"chainId,omitempty"`
+	Nonce           uint64         `json:"nonce"`
+	From            string         `json:"from"`
+	To              string         `json:"to,omitempty"`
+	ContractAddress string         `json:"contractAddress,omitempty"`
+	Broadcast       bool           `json:"broadcast"`
+	Receipt         *receiptOutput `json:"receipt,omitempty"` // with --wait
+}
+
+type receiptOutput struct {
+	BlockNumber uint64 `json:"blockNumber"`
+	BlockHash   string `json:"blockHash"`
+	GasUsed     uint64 `json:"gasUsed"`
+	Status      string `json:"status"`
+	Exception   string `json:"exception,omitempty"`
+}
+
+// print the tx and its result (which may be nil) as one line of json
+func printJSON(tx core.Tx, r *core.TxResult) error {
+	out := txOutput{
+		Raw:   fmt.Sprintf("0x%x", tx.Bytes()),
+		Type:  core.TxTypeNames[tx.Type()],
+		Nonce: core.TxNonce(tx),
+	}
+	if tx.Signed() {
+		out.Hash = core.TxHash(tx).Hex()
+		out.Signature = fmt.Sprintf("0x%x", tx.Signature())
+	}
+	if chainID := tx.ChainID(); chainID != nil {
+		out.ChainID = chainID.String()
+	}
+	if from := tx.From(); from != nil {
+		out.From = utils.ChecksumAddress(from.Bytes())
+	}
+	if to := core.TxRecipient(tx); to != nil {
+		out.To = utils.ChecksumAddress(to.Bytes())
+	} else if addr := tx.CreateAddress(); addr != nil {
+		out.ContractAddress = utils.ChecksumAddress(addr)
+	}
+	if create2Address != nil {
+		out.ContractAddress = utils.ChecksumAddress(create2Address)
+	}
+	if r != nil {
+		out.Broadcast = true
+		out.Hash = fmt.Sprintf("0x%x", r.Hash)
+		if r.BlockHash != nil {
+			out.Receipt = &receiptOutput{
+				BlockNumber: r.BlockNumber,
+				BlockHash:   fmt.Sprintf("0x%x", r.BlockHash),
+				GasUsed:     r.GasUsed,
+				Status:      r.Status,
+				Exception:   r.Exception,
+			}
+		}
+	}
+	return json.NewEncoder(jsonOut).Encode(out)
+}
+
+// estimate the gas if it wasn't given, check the caps,
+// and show what the tx could cost before it's signed
+func fillGas(tx core.Tx) error {
+	estimate, err := core.FillGas(tx)
+	if err != nil {
+		return err
+	}
+	if core.MaxGas, err = parseCap(MaxGasFlag, "max gas"); err != nil {
+		return err
+	}
+	if core.MaxPrice, err = parseCap(MaxPriceFlag, "max price"); err != nil {
+		return err
+	}
+	if err := core.CheckCaps(tx); err != nil {
+		return err
+	}
+
+	if estimate != nil {
+		logger.Printf("Gas:       %v (estimated %v x %v)\n", core.GasLimit(tx), estimate, GasMultiplierFlag)
+	} else {
+		logger.Printf("Gas:       %v\n", core.GasLimit(tx))
+	}
+	if dtx, ok := tx.(*core.DynamicFeeTx); ok {
+		logger.Printf("Max Fee:   %s gwei (priority fee %s gwei)\n", utils.FormatUnits(dtx.MaxFee, utils.GweiDecimals), utils.FormatUnits(dtx.PriorityFee, utils.GweiDecimals))
+	} else {
+		logger.Printf("Gas Price: %s gwei\n", utils.FormatUnits(core.GasPrice(tx), utils.GweiDecimals))
+	}
+	logger.Printf("Max Cost:  %s ether\n", utils.FormatUnits(core.MaxCost(tx), utils.EtherDecimals))
+	return nil
+}
+
+// checks what a simulated tx returns, if set
+var checkReturn func(ret []byte) error
+
+// the address a --create2 tx deploys to
+var create2Address []byte
+
+// dry run a tx that's about to be broadcast, and refuse to
+// broadcast it if it would fail, unless --force
+func simulate(tx core.Tx, block string) error {
+	if !BroadcastFlag || !SimulateFlag {
+		return nil
+	}
+	err := core.CheckBalance(tx, block)
+	if err == nil {
+		var contract *abi.ABI
+		if AbiFlag != "" {
+			// for custom errors
+			contract, _ = abi.LoadABI(AbiFlag)
+		}
+		var ret []byte
+		ret, err = core.Simulate(tx, contract, block)
+		if err == nil && checkReturn != nil {
+			err = checkReturn(ret)
+		}
+	}
+	if err == nil {
+		logger.Printf("Simulated: success\n")
+		return nil
+	}
+	if ForceFlag {
+		logger.Printf("Simulated: %v (broadcasting anyway with --force)\n", err)
+		return nil
+	}
+	return fmt.Errorf("%v (not broadcast, use --force to broadcast anyway)", err)
+}
+
+func parseCap(s, name string) (*big.Int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	x, err := utils.ParseUnits(s)
+	if err != nil {
+		return nil, fmt.Errorf("bad %s: %v", name, err)
+	}
+	return x, nil
+}
+
+// exit on error, giving back the tx's nonce if it was reserved
+func ifExitRelease(err error, tx *core.Transaction) {
+	if err == nil {
+		return
+	}
+	if core.Nonces != nil && NonceFlag == "" {
+		if err := core.Nonces.Release(*tx.From(), tx.Nonce); err != nil {
+			logger.Warnf("Could not release nonce %d: %v\n", tx.Nonce, err)
+		}
+	}
+	common.Exit(err)
+}
+
+// keep the local nonce ahead of broadcast txs (eg. ones with an explicit --nonce)
+func nonceUsed(tx *core.Transaction) {
+	if core.Nonces == nil {
+		return
+	}
+	if err := core.Nonces.Used(*tx.From(), tx.Nonce); err != nil {
+		logger.Warnf("Could not update the local nonce: %v\n", err)
+	}
+}
+
+func addToBundle(tx core.Tx) error {
+	if SignFlag || BroadcastFlag {
+		return fmt.Errorf("--bundle can not be used with --sign or --broadcast (use ethtx sign on the bundle)")
+	}
+	bundle, err := core.LoadOrNewBundle(BundleFlag)
+	if err != nil {
+		return err
+	}
+	btx, err := core.NewBundleTx(tx)
+	if err != nil {
+		return err
+	}
+	bundle.Transactions = append(bundle.Transactions, btx)
+	if err := bundle.Write(BundleFlag); err != nil {
+		return err
+	}
+	logger.Printf("Added tx to %s (%d txs)\n", BundleFlag, len(bundle.Transactions))
+	return nil
+}
+
+func setChainID(tx *core.Transaction) error {
+	chainID, err := core.ResolveChainID(ChainIDFlag)
+	if err != nil {
+		return err
+	}
+	if chainID == nil {
+		logger.Warnln("Transaction is not replay protected (no chain id)")
+	}
+	tx.SetChainID(chainID)
+	return nil
+}
+
+func logTxResult(r *core.TxResult) {
+	logger.Printf("TxID: %X\n", r.Hash)
+	if r.Address != nil {
+		logger.Printf("Contract Address: %s\n", utils.ChecksumAddress(r.Address))
+	} else if create2Address != nil {
+		logger.Printf("Contract Address: %s\n", utils.ChecksumAddress(create2Address))
+	}
+	if !WaitFlag {
+		return
+	}
+	logger.Printf("Block: %d (%X)\n", r.BlockNumber, r.BlockHash)
+	logger.Printf("Gas Used: %d\n", r.GasUsed)
+	logger.Printf("Status: %s\n", r.Status)
+	if r.Exception != "" {
+		common.Exit(fmt.Errorf("Exception: %s", r.Exception))
+	}
+	if create2Address != nil {
+		// the factory may not have deployed anything, without reverting
+		code, err := core.GetCode(create2Address, "latest")
+		common.IfExit(err)
+		if len(code) == 0 {
+			common.Exit(fmt.Errorf("the tx was mined, but there's no contract at %s", utils.ChecksumAddress(create2Address)))
+		}
+	}
+}
+
+// the passphrase for a --keystore key, from the --password-file or the terminal
+func keystorePassphrase(addr ethcommon.Address) (string, error) {
+	if PasswordFileFlag != "" {
+		b, err := ioutil.ReadFile(PasswordFileFlag)
+		if err != nil {
+			return "", err
+		}
+		// only the first line is the passphrase
+		return strings.TrimRight(strings.SplitN(string(b), "\n", 2)[0], "\r"), nil
+	}
+
+	fmt.Fprintf(os.Stderr, "Passphrase for %s: ", utils.ChecksumAddress(addr.Bytes()))
+	// don't echo the passphrase if we're on a terminal
+	if fd := int(os.Stdin.Fd()); terminal.IsTerminal(fd) {
+		pass, err := terminal.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", fmt.Errorf("Error reading passphrase: %v", err)
+		}
+		return string(pass), nil
+	}
+	pass, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && pass == "" {
+		return "", fmt.Errorf("Error reading passphrase: %v", err)
+	}
+	return strings.TrimRight(pass, "\r\n"), nil
+}
+
+// the wallet of --mnemonic-file, and --hd-path
+func loadWallet() (*hdwallet.Wallet, hdwallet.Path) {
+	mnemonic, err := hdwallet.LoadMnemonic(MnemonicFileFlag)
+	common.IfExit(err)
+	wallet, err := hdwallet.New(mnemonic, "")
+	common.IfExit(err)
+	path, err := hdwallet.ParsePath(HDPathFlag)
+	common.IfExit(err)
+	return wallet, path
+}
+
+func cliDecode(cmd *cobra.Command, args []string) {
+	if len(args) == 0 {
+		common.Exit(fmt.Errorf("must pass some transaction bytes (or - to read them from stdin)"))
+	}
+	txHex := args[0]
+	if txHex == "-" {
+		b, err := ioutil.ReadAll(os.Stdin)
+		common.IfExit(err)
+		txHex = string(b)
+	}
+	txBytes, err := hex.DecodeString(utils.StripHex(strings.TrimSpace(txHex)))
+	common.IfExit(err)
+
+	tx, err := core.DecodeTx(txBytes)
+	common.IfExit(err)
+
+	var (
+		nonce     uint64
+		to        *ethcommon.Address
+		amount    *big.Int
+		gasLimit  *big.Int
+		data      []byte
+		accessLst core.AccessList
+		fees      string
+		v, r, s   *big.Int
+	)
+	switch tx := tx.(type) {
+	case *core.Transaction:
+		logger.Println("Type:        legacy")
+		nonce, to, amount, gasLimit, data = tx.Nonce, tx.Recipient, tx.Amount, tx.GasLimit, tx.Data
+		v, r, s = tx.V, tx.R, tx.S
+		fees = fmt.Sprintf("GasPrice:    %s wei (%s gwei)\n", tx.Price, utils.FormatUnits(tx.Price, utils.GweiDecimals))
+	case *core.AccessListTx:
+		logger.Println("Type:        0x01 (access list, EIP-2930)")
+		nonce, to, amount, gasLimit, data = tx.Nonce, tx.Recipient, tx.Amount, tx.GasLimit, tx.Data
+		accessLst, v, r, s = tx.AccessList, tx.V, tx.R, tx.S
+		fees = fmt.Sprintf("GasPrice:    %s wei (%s gwei)\n", tx.Price, utils.FormatUnits(tx.Price, utils.GweiDecimals))
+	case *core.DynamicFeeTx:
+		logger.Println("Type:        0x02 (dynamic fee, EIP-1559)")
+		nonce, to, amount, gasLimit, data = tx.Nonce, tx.Recipient, tx.Amount, tx.GasLimit, tx.Data
+		accessLst, v, r, s = tx.AccessList, tx.V, tx.R, tx.S
+		fees = fmt.Sprintf("MaxFee:      %s wei (%s gwei)\n", tx.MaxFee, utils.FormatUnits(tx.MaxFee, utils.GweiDecimals))
+		fees += fmt.Sprintf("PriorityFee: %s wei (%s gwei)\n", tx.PriorityFee, utils.FormatUnits(tx.PriorityFee, utils.GweiDecimals))
+	}
+
+	if chainID := tx.ChainID(); chainID != nil {
+		logger.Printf("ChainID:     %s\n", chainID)
+	} else {
+		logger.Println("ChainID:     none")
+	}
+	logger.Printf("Nonce:       %d\n", nonce)
+	if to != nil {
+		logger.Printf("To:          %s\n", utils.ChecksumAddress(to.Bytes()))
+	} else {
+		logger.Println("To:          none (contract creation)")
+	}
+	logger.Printf("Amount:      %s wei (%s ether)\n", amount, utils.FormatUnits(amount, utils.EtherDecimals))
+	logger.Printf("GasLimit:    %s\n", gasLimit)
+	logger.Printf("%s", fees)
+	logger.Printf("Data:        %X (%d bytes)\n", data, len(data))
+	if tx.Type() != core.LegacyTxType {
+		logger.Printf("AccessList:  %d entries%v\n", len(accessLst), accessLst)
+	}
+	logger.Printf("Hash:        %X\n", core.TxHash(tx).Bytes())
+
+	if !tx.Signed() {
+		logger.Println("Signature:   none (unsigned)")
+		if tx.Type() == core.LegacyTxType && tx.ChainID() != nil {
+			logger.Printf("EIP-155:     will be replay protected on chain %s\n", tx.ChainID())
+		}
+		return
+	}
+	logger.Printf("V:           %s\n", v)
+	logger.Printf("R:           %X\n", r.Bytes())
+	logger.Printf("S:           %X\n", s.Bytes())
+
+	if tx.Type() == core.LegacyTxType {
+		if tx.ChainID() != nil {
+			logger.Printf("EIP-155:     replay protected on chain %s\n", tx.ChainID())
+		} else {
+			logger.Println("EIP-155:     NOT replay protected (valid on any chain)")
+		}
+	}
+
+	from, err := core.Sender(tx)
+	if err != nil {
+		logger.Printf("Valid:       false (%v)\n", err)
+		common.Exit(fmt.Errorf("invalid signature"))
+	}
+	logger.Printf("From:        %s\n", utils.ChecksumAddress(from.Bytes()))
+	if to == nil {
+		logger.Printf("Contract:    %s\n", utils.ChecksumAddress(core.ContractAddress(&from, nonce)))
+	}
+	if sig, _ := core.RawSignature(tx); !crypto.ValidSignatureValues(sig, true) {
+		logger.Println("Valid:       false (S is not in the lower half of the curve order, as required since homestead)")
+		common.Exit(fmt.Errorf("invalid signature"))
+	}
+	logger.Println("Valid:       true")
+}
+
+func cliSign(cmd *cobra.Command, args []string) {
+	if len(args) == 0 {
+		common.Exit(fmt.Errorf("must pass an unsigned transaction, a bundle file, or - to read either from stdin"))
+	}
+	b, err := readArg(args[0])
+	common.IfExit(err)
+	b = []byte(strings.TrimSpace(string(b)))
+
+	if len(b) > 0 && b[0] == '{' {
+		common.IfExit(signBundle(b))
+		return
+	}
+
+	txBytes, err := hex.DecodeString(utils.StripHex(string(b)))
+	common.IfExit(err)
+	tx, err := core.DecodeTx(txBytes)
+	common.IfExit(err)
+
+	if AddressFlag == "" {
+		common.Exit(fmt.Errorf("--addr must be given"))
+	}
+	from, err := parseAddress(AddressFlag)
 	common.IfExit(err)
+	tx.SetFrom(ethcommon.BytesToAddress(from))
+
+	// unsigned txs carry their chain id, except legacy ones crafted without replay protection
+	if ChainIDFlag != "" {
+		chainID, err := core.ResolveChainID(ChainIDFlag)
+		common.IfExit(err)
+		legacyTx, ok := tx.(*core.Transaction)
+		if ok && tx.ChainID() == nil {
+			legacyTx.SetChainID(chainID)
+		} else if tx.ChainID() == nil || chainID == nil || tx.ChainID().Cmp(chainID) != 0 {
+			common.Exit(fmt.Errorf("--chain-id %s does not match the transaction's chain id %v", ChainIDFlag, tx.ChainID()))
+		}
+	}
+	common.IfExit(signDecoded(tx))
+	if jsonOut != nil {
+		common.IfExit(printJSON(tx, nil))
+	}
+	logger.Printf("%X\n", tx.Bytes())
+}
+
+// an arg that's given as is, as a file, or as - to read stdin
+func readArg(arg string) ([]byte, error) {
+	if arg == "-" {
+		return ioutil.ReadAll(os.Stdin)
+	} else if _, err := os.Stat(arg); err == nil {
+		return ioutil.ReadFile(arg)
+	}
+	return []byte(arg), nil
+}
+
+// sign every tx in the bundle and write out the signed bundle
+func signBundle(b []byte) error {
+	bundle, err := core.ParseBundle(b)
+	if err != nil {
+		return err
+	}
+	for i, btx := range bundle.Transactions {
+		tx, err := btx.Tx()
+		if err != nil {
+			return fmt.Errorf("bundle tx %d: %v", i, err)
+		}
+		if AddressFlag != "" {
+			addr, err := parseAddress(AddressFlag)
+			if err != nil {
+				return err
+			}
+			if ethcommon.BytesToAddress(addr) != *tx.From() {
+				return fmt.Errorf("bundle tx %d is from %s, not --addr %s", i, utils.ChecksumAddress(tx.From().Bytes()), AddressFlag)
+			}
+		}
+		fmt.Fprintf(os.Stderr, "Signing bundle tx %d from %s:%v", i, utils.ChecksumAddress(tx.From().Bytes()), tx)
+		if err := signDecoded(tx); err != nil {
+			return fmt.Errorf("bundle tx %d: %v", i, err)
+		}
+		btx.SetSigned(tx)
+	}
+
+	if OutFlag != "" {
+		return bundle.Write(OutFlag)
+	}
+	out, err := bundle.Bytes()
+	if err != nil {
+		return err
+	}
+	logger.Printf("%s", out)
+	return nil
+}
+
+func signDecoded(tx core.Tx) error {
+	if tx.Signed() {
+		return fmt.Errorf("transaction is already signed")
+	}
+	if tx.ChainID() == nil {
+		logger.Warnln("Transaction is not replay protected (no chain id)")
+	}
 	logger.Infoln(tx)
-	r, err := core.SignAndBroadcast(SignAddrFlag, tx, SignFlag, BroadcastFlag, WaitFlag)
+	return tx.Sign(signer)
+}
+
+//---------------------------------------------------------------
+// signed messages
+
+func cliSignMessage(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		common.Exit(fmt.Errorf("must pass the message, a file of it, or - to read it from stdin"))
+	}
+	msg, err := readArg(args[0])
 	common.IfExit(err)
-	if BinaryFlag {
-		logger.Printf("%X\n", tx.Bytes())
+	if HexFlag {
+		msg, err = hex.DecodeString(utils.StripHex(strings.TrimSpace(string(msg))))
+		common.IfExit(err)
 	}
-	if SignFlag {
-		logger.Infof("Signature: %X\n", tx.Signature())
+	signMessage(crypto.TextHash(msg))
+}
+
+func cliSignTypedData(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		common.Exit(fmt.Errorf("must pass the typed data json, a file of it, or - to read it from stdin"))
 	}
-	if BroadcastFlag {
-		logger.Println("TxID:", r)
-		logger.Printf("Contract Address: %X\n", tx.CreateAddress())
+	b, err := readArg(args[0])
+	common.IfExit(err)
+	td, err := eip712.Parse(b)
+	common.IfExit(err)
+	hash, err := td.Hash()
+	common.IfExit(err)
+	signMessage(hash)
+}
+
+// what --output=json prints for a signed message
+type messageOutput struct {
+	Signer    string `json:"signer"`
+	Hash      string `json:"hash"`
+	Signature string `json:"signature"`
+}
+
+// sign the hash of a message with --addr's key
+func signMessage(hash []byte) {
+	if AddressFlag == "" {
+		common.Exit(fmt.Errorf("--addr must be given"))
+	}
+	addrBytes, err := parseAddress(AddressFlag)
+	common.IfExit(err)
+	addr := ethcommon.BytesToAddress(addrBytes)
+	sig, err := signer.Sign(hash, addr)
+	common.IfExit(err)
+	// make sure it's from the right key, as for txs
+	recovered, err := crypto.RecoverAddress(hash, sig)
+	common.IfExit(err)
+	if recovered != addr {
+		common.Exit(fmt.Errorf("signer returned a signature from %s, not %s", utils.ChecksumAddress(recovered.Bytes()), utils.ChecksumAddress(addr.Bytes())))
+	}
+
+	out := messageOutput{
+		Signer:    utils.ChecksumAddress(addr.Bytes()),
+		Hash:      fmt.Sprintf("0x%x", hash),
+		Signature: fmt.Sprintf("0x%x", crypto.MessageSignature(sig)),
+	}
+	if jsonOut != nil {
+		common.IfExit(json.NewEncoder(jsonOut).Encode(out))
 	}
+	logger.Infof("Hash: %s\n", out.Hash)
+	logger.Printf("%s\n", out.Signature)
 }
 
-func cliCall(cmd *cobra.Command, args []string) {
-	tx, err := core.Call(AddressFlag, ToFlag, AmtFlag, GasFlag, GasPriceFlag, DataFlag, NonceFlag)
+//---------------------------------------------------------------
+// local nonces
+
+func cliNonceShow(cmd *cobra.Command, args []string) {
+	addr, _ := nonceArgs(args)
+	nm := nonceManager()
+	local, ok, err := nm.Local(addr)
+	common.IfExit(err)
+	if ok {
+		logger.Printf("Local:   %d\n", local)
+	} else {
+		logger.Printf("Local:   none\n")
+	}
+	latest, err := core.LatestNonce(addr)
+	common.IfExit(err)
+	pending, err := core.PendingNonce(addr)
 	common.IfExit(err)
+	logger.Printf("Latest:  %d\n", latest)
+	logger.Printf("Pending: %d\n", pending)
+	next := pending
+	if ok && local > pending {
+		next = local
+	}
+	logger.Printf("Next:    %d\n", next)
+}
+
+func cliNonceReset(cmd *cobra.Command, args []string) {
+	addr, nonceS := nonceArgs(args)
+	nm := nonceManager()
+	if nonceS == "" {
+		common.IfExit(nm.Reset(addr))
+		logger.Printf("Forgot the local nonce for %s\n", utils.ChecksumAddress(addr.Bytes()))
+		return
+	}
+	nonce, err := strconv.ParseUint(nonceS, 0, 64)
+	if err != nil {
+		common.Exit(fmt.Errorf("nonce %s is not a number: %v", nonceS, err))
+	}
+	common.IfExit(nm.Set(addr, nonce))
+	logger.Printf("Local nonce for %s is now %d\n", utils.ChecksumAddress(addr.Bytes()), nonce)
+}
+
+func cliNonceResync(cmd *cobra.Command, args []string) {
+	addr, _ := nonceArgs(args)
+	nonce, err := nonceManager().Resync(addr)
+	common.IfExit(err)
+	logger.Printf("Local nonce for %s is now %d\n", utils.ChecksumAddress(addr.Bytes()), nonce)
+}
+
+func nonceManager() *core.NonceManager {
+	if NonceDirFlag == "" {
+		common.Exit(fmt.Errorf("--nonce-dir must be given"))
+	}
+	return core.NewNonceManager(NonceDirFlag)
+}
+
+// [addr] [nonce], where addr defaults to --addr
+func nonceArgs(args []string) (ethcommon.Address, string) {
+	addrS := AddressFlag
+	if len(args) > 0 && (len(utils.StripHex(args[0])) == 40 || ens.IsName(args[0])) {
+		addrS, args = resolveAddr(args[0]), args[1:]
+	}
+	if addrS == "" {
+		common.Exit(fmt.Errorf("must pass an address or --addr"))
+	}
+	addr, err := utils.ParseAddress(addrS)
+	common.IfExit(err)
+	var nonceS string
+	if len(args) > 0 {
+		nonceS = args[0]
+	}
+	return ethcommon.BytesToAddress(addr), nonceS
+}
+
+//---------------------------------------------------------------
+// erc-20 tokens
+
+func cliTokenTransfer(cmd *cobra.Command, args []string) {
+	if len(args) != 3 {
+		common.Exit(fmt.Errorf("must pass the token, the recipient and the amount"))
+	}
+	addrs := tokenArgs(args[:2])
+	tokenAddr, to := addrs[0], addrs[1]
+	amount := tokenAmount(tokenAddr, args[2])
+	logger.Printf("Transfer:  %s to %s\n", formatTokens(tokenAddr, amount), utils.ChecksumAddress(to))
+	data, err := token.TransferData(to, amount)
+	common.IfExit(err)
+	processTokenTx(tokenAddr, data)
+}
+
+func cliTokenApprove(cmd *cobra.Command, args []string) {
+	if len(args) != 3 {
+		common.Exit(fmt.Errorf("must pass the token, the spender and the amount"))
+	}
+	addrs := tokenArgs(args[:2])
+	tokenAddr, spender := addrs[0], addrs[1]
+	amount := token.MaxAmount
+	if args[2] == "max" {
+		logger.Printf("Approve:   %s to spend any amount\n", utils.ChecksumAddress(spender))
+	} else {
+		amount = tokenAmount(tokenAddr, args[2])
+		logger.Printf("Approve:   %s to spend %s\n", utils.ChecksumAddress(spender), formatTokens(tokenAddr, amount))
+	}
+	data, err := token.ApproveData(spender, amount)
+	common.IfExit(err)
+	processTokenTx(tokenAddr, data)
+}
+
+func cliTokenTransferFrom(cmd *cobra.Command, args []string) {
+	if len(args) != 4 {
+		common.Exit(fmt.Errorf("must pass the token, the sender, the recipient and the amount"))
+	}
+	addrs := tokenArgs(args[:3])
+	tokenAddr := addrs[0]
+	amount := tokenAmount(tokenAddr, args[3])
+	logger.Printf("Transfer:  %s from %s to %s\n", formatTokens(tokenAddr, amount), utils.ChecksumAddress(addrs[1]), utils.ChecksumAddress(addrs[2]))
+	data, err := token.TransferFromData(addrs[1], addrs[2], amount)
+	common.IfExit(err)
+	processTokenTx(tokenAddr, data)
+}
+
+// the token and the other addresses (or ENS names) of a token command
+func tokenArgs(args []string) [][]byte {
+	addrs := make([][]byte, len(args))
+	for i, arg := range args {
+		addr, err := parseAddress(arg)
+		common.IfExit(err)
+		addrs[i] = addr
+	}
+	return addrs
+}
+
+// an amount in the token's decimals (eg. 1.5), or hex in its smallest unit
+func tokenAmount(tokenAddr []byte, s string) *big.Int {
+	amount, err := token.ParseAmount(core.EthClient, tokenAddr, s)
+	common.IfExit(err)
+	return amount
+}
+
+func formatTokens(tokenAddr []byte, amount *big.Int) string {
+	decimals, err := token.Decimals(core.EthClient, tokenAddr)
+	common.IfExit(err)
+	return token.Format(amount, decimals, token.Symbol(core.EthClient, tokenAddr))
+}
+
+// call the token. Nothing is sent with the call unless --amt is given, and the
+// simulation fails if the token would return false rather than revert
+func processTokenTx(tokenAddr, data []byte) {
+	amt := AmtFlag
+	if amt == "" {
+		amt = "0"
+	}
+	tx, err := core.Call(AddressFlag, utils.ChecksumAddress(tokenAddr), amt, GasFlag, GasPriceFlag, fmt.Sprintf("0x%x", data), NonceFlag)
+	common.IfExit(err)
+	checkReturn = token.CheckReturn
+	processTx(tx)
+}
+
+//---------------------------------------------------------------
+// hd wallet accounts
+
+type accountOutput struct {
+	Path    string `json:"path"`
+	Address string `json:"address"`
+}
+
+func cliAccountsDerive(cmd *cobra.Command, args []string) {
+	if MnemonicFileFlag == "" {
+		common.Exit(fmt.Errorf("--mnemonic-file must be given"))
+	}
+	if CountFlag < 1 {
+		common.Exit(fmt.Errorf("--count must be at least 1"))
+	}
+	wallet, path := loadWallet()
+	accounts, err := wallet.Accounts(path, CountFlag)
+	common.IfExit(err)
+	out := make([]accountOutput, len(accounts))
+	for i, account := range accounts {
+		out[i] = accountOutput{account.Path.String(), utils.ChecksumAddress(account.Address.Bytes())}
+	}
+	if jsonOut != nil {
+		common.IfExit(json.NewEncoder(jsonOut).Encode(out))
+		return
+	}
+	for _, account := range out {
+		logger.Printf("%s %s\n", account.Path, account.Address)
+	}
+}
+
+//---------------------------------------------------------------
+// nfts
+
+func cliNFTTransfer(cmd *cobra.Command, args []string) {
+	nftTransfer(args, false)
+}
+
+func cliNFTSafeTransfer(cmd *cobra.Command, args []string) {
+	nftTransfer(args, true)
+}
+
+func nftTransfer(args []string, safe bool) {
+	if len(args) < 3 {
+		common.Exit(fmt.Errorf("must pass the contract, the recipient and at least one token id"))
+	}
+	addrs := tokenArgs(args[:2])
+	contract, to := addrs[0], addrs[1]
+	from := FromFlag
+	if from == "" {
+		from = AddressFlag
+	}
+	fromAddr, err := parseAddress(from)
+	common.IfExit(err)
+	data, err := hex.DecodeString(utils.StripHex(DataFlag))
+	if err != nil {
+		common.Exit(fmt.Errorf("bad --data: %v", err))
+	}
+
+	var ids, amounts []*big.Int
+	var tokens []string
+	for _, arg := range args[2:] {
+		id, amount, err := parseNFTArg(arg)
+		common.IfExit(err)
+		ids, amounts = append(ids, id), append(amounts, amount)
+		tokens = append(tokens, fmt.Sprintf("%v of id %v", amount, id))
+	}
+
+	std := detectNFT(contract)
+	if std == nft.ERC1155 && !safe {
+		logger.Infoln("ERC-1155 has only safe transfers")
+	}
+	if std == nft.ERC721 {
+		tokens = []string{fmt.Sprintf("id %v", ids[0])}
+	}
+	callData, err := nft.TransferData(std, fromAddr, to, ids, amounts, safe, data)
+	common.IfExit(err)
+	logger.Printf("Transfer:  %s from %s to %s\n", strings.Join(tokens, ", "), utils.ChecksumAddress(fromAddr), utils.ChecksumAddress(to))
+	processNFTTx(contract, callData)
+}
+
+// <id>, or <id>:<amount> of ERC-1155 tokens (the amount defaults to 1)
+func parseNFTArg(arg string) (*big.Int, *big.Int, error) {
+	spl := strings.SplitN(arg, ":", 2)
+	id, err := nft.ParseID(spl[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(spl) == 1 {
+		return id, big.NewInt(1), nil
+	}
+	amount, err := utils.ParseDecimal(spl[1], 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("bad amount of id %v: %v", id, err)
+	}
+	return id, amount, nil
+}
+
+func cliNFTApprove(cmd *cobra.Command, args []string) {
+	if len(args) != 3 {
+		common.Exit(fmt.Errorf("must pass the contract, the spender and the token id"))
+	}
+	addrs := tokenArgs(args[:2])
+	contract, spender := addrs[0], addrs[1]
+	id, err := nft.ParseID(args[2])
+	common.IfExit(err)
+	std := detectNFT(contract)
+	data, err := nft.ApproveData(std, spender, id)
+	common.IfExit(err)
+	logger.Printf("Approve:   %s to transfer id %v\n", utils.ChecksumAddress(spender), id)
+	processNFTTx(contract, data)
+}
+
+func cliNFTSetApprovalForAll(cmd *cobra.Command, args []string) {
+	if len(args) != 2 && len(args) != 3 {
+		common.Exit(fmt.Errorf("must pass the contract and the operator, and optionally true or false"))
+	}
+	addrs := tokenArgs(args[:2])
+	contract, operator := addrs[0], addrs[1]
+	approved := true
+	if len(args) == 3 {
+		var err error
+		approved, err = strconv.ParseBool(args[2])
+		if err != nil {
+			common.Exit(fmt.Errorf("approval must be true or false, got %s", args[2]))
+		}
+	}
+	detectNFT(contract)
+	if approved {
+		logger.Printf("Approve:   %s to transfer all tokens\n", utils.ChecksumAddress(operator))
+	} else {
+		logger.Printf("Revoke:    %s from transferring any tokens\n", utils.ChecksumAddress(operator))
+	}
+	data, err := nft.SetApprovalForAllData(operator, approved)
+	common.IfExit(err)
+	processNFTTx(contract, data)
+}
+
+func detectNFT(contract []byte) nft.Standard {
+	std, err := nft.Detect(core.EthClient, contract)
+	common.IfExit(err)
+	logger.Printf("Standard:  %s\n", std)
+	return std
+}
+
+// call the NFT contract. Nothing is sent with the call unless --amt is given
+func processNFTTx(contract, data []byte) {
+	amt := AmtFlag
+	if amt == "" {
+		amt = "0"
+	}
+	tx, err := core.Call(AddressFlag, utils.ChecksumAddress(contract), amt, GasFlag, GasPriceFlag, fmt.Sprintf("0x%x", data), NonceFlag)
+	common.IfExit(err)
+	processTx(tx)
+}
+
+//---------------------------------------------------------------
+// replacing pending txs
+//
+// The replacement has the original's nonce, so the nonce manager isn't used
+
+func cliSpeedup(cmd *cobra.Command, args []string) {
+	tx := pendingTx(args)
+	processReplacement(tx)
+}
+
+func cliCancel(cmd *cobra.Command, args []string) {
+	tx := pendingTx(args)
+	cancel, err := core.CancelTx(tx)
+	common.IfExit(err)
+	processReplacement(cancel)
+}
+
+// the pending tx to replace, which must be from --addr if it's given
+func pendingTx(args []string) core.Tx {
+	if len(args) != 1 {
+		common.Exit(fmt.Errorf("the hash of the tx to replace must be given"))
+	}
+	tx, err := core.PendingTx(args[0])
+	common.IfExit(err)
+	from := tx.From().Bytes()
+	if AddressFlag != "" {
+		addr, err := utils.ParseAddress(AddressFlag)
+		common.IfExit(err)
+		if !bytes.Equal(addr, from) {
+			common.Exit(fmt.Errorf("tx %s was sent by %s, not --addr %s", args[0], utils.ChecksumAddress(from), utils.ChecksumAddress(addr)))
+		}
+	}
+	fee := "gas price"
+	if tx.Type() == core.DynamicFeeTxType {
+		fee = "max fee"
+	}
+	logger.Printf("Replacing: %s (from %s, %s %s gwei)\n", args[0], utils.ChecksumAddress(from), fee, utils.FormatUnits(core.GasPrice(tx), utils.GweiDecimals))
+	return tx
+}
+
+// bump the fees of a replacement, then sign, broadcast and print it as requested
+func processReplacement(tx core.Tx) {
+	core.PriceBump = BumpFlag
+	price, err := parseCap(GasPriceFlag, "price")
+	common.IfExit(err)
+	priorityFee, err := parseCap(PriorityFeeFlag, "priority fee")
+	common.IfExit(err)
+	maxFee, err := parseCap(MaxFeeFlag, "max fee")
+	common.IfExit(err)
+	common.IfExit(core.BumpFees(tx, price, priorityFee, maxFee))
+	common.IfExit(fillGas(tx))
 	logger.Infoln(tx)
-	r, err := core.SignAndBroadcast(SignAddrFlag, tx, SignFlag, BroadcastFlag, WaitFlag)
+	// the pending state already has the original
+	common.IfExit(simulate(tx, "latest"))
+	r, err := core.SignAndBroadcast(signer, tx, SignFlag, BroadcastFlag, WaitFlag)
 	common.IfExit(err)
-	if BinaryFlag {
-		logger.Printf("%X\n", tx.Bytes())
+	logSigned(tx, r)
+}
+
+//---------------------------------------------------------------
+// ens
+
+// resolve an address that's a name in the profile's address book or an ENS name,
+// showing what it resolved to (before anything is signed, so a hijacked name doesn't go unnoticed)
+func resolveAddr(s string) string {
+	if addr, ok := utils.LookupAddress(s); ok {
+		return addr
 	}
-	if SignFlag {
-		logger.Infof("Signature: %X\n", tx.Signature())
+	if !ens.IsName(s) {
+		return s
 	}
-	if BroadcastFlag {
-		logger.Println("TxID:", r)
+	registry, err := ens.Registry(core.EthClient, ENSRegistryFlag)
+	common.IfExit(err)
+	addr, resolver, err := ens.Resolve(core.EthClient, registry, s)
+	common.IfExit(err)
+	logger.Printf("Resolved %s to %s (resolver %s)\n", s, utils.ChecksumAddress(addr), utils.ChecksumAddress(resolver))
+	return utils.ChecksumAddress(addr)
+}
+
+// resolve a name given for an address arg of a method,
+// and say what it resolved to before anything is signed
+func resolveArg(s string) (string, error) {
+	addr := resolveAddr(s)
+	if _, ok := utils.LookupAddress(s); ok {
+		logger.Printf("Resolved %s to %s (address book)\n", s, addr)
+	}
+	return addr, nil
+}
+
+//---------------------------------------------------------------
+// name registrar
+//
+// The registrar ignores calls from anyone but a name's owner,
+// so check ownership first rather than waste a tx
+
+func cliNameReserve(cmd *cobra.Command, args []string) {
+	name := nameArg(args, 1)
+	owner, err := namereg.Owner(core.EthClient, RegistrarFlag, name)
+	common.IfExit(err)
+	if owner != nil {
+		common.Exit(fmt.Errorf("%s is already owned by %s", name, utils.ChecksumAddress(owner)))
+	}
+	data, err := namereg.ReserveData(name)
+	common.IfExit(err)
+	processNameTx(data)
+}
+
+func cliNameSet(cmd *cobra.Command, args []string) {
+	name := nameArg(args, 1)
+	addrS := AddressFlag
+	if len(args) > 1 {
+		addrS = args[1]
+	}
+	addr, err := parseAddress(addrS)
+	common.IfExit(err)
+	checkNameOwner(name)
+	data, err := namereg.SetAddressData(name, addr, PrimaryFlag)
+	common.IfExit(err)
+	processNameTx(data)
+}
+
+func cliNameTransfer(cmd *cobra.Command, args []string) {
+	name := nameArg(args, 2)
+	newOwner, err := parseAddress(args[1])
+	common.IfExit(err)
+	checkNameOwner(name)
+	data, err := namereg.TransferData(name, newOwner)
+	common.IfExit(err)
+	processNameTx(data)
+}
+
+func cliNameOwner(cmd *cobra.Command, args []string) {
+	name := nameArg(args, 1)
+	owner, err := namereg.Owner(core.EthClient, RegistrarFlag, name)
+	common.IfExit(err)
+	if owner == nil {
+		logger.Printf("%s is not reserved\n", name)
+		return
+	}
+	logger.Printf("Owner: %s\n", utils.ChecksumAddress(owner))
+	addr, err := namereg.Resolve(core.EthClient, RegistrarFlag, name)
+	common.IfExit(err)
+	if addr != nil {
+		logger.Printf("Address: %s\n", utils.ChecksumAddress(addr))
+	}
+}
+
+func nameArg(args []string, n int) string {
+	if len(args) < n {
+		common.Exit(fmt.Errorf("must pass %d args (see --help)", n))
 	}
+	return args[0]
 }
 
-func cliName(cmd *cobra.Command, args []string) {
-	logger.Errorln("not implemented yet")
+func checkNameOwner(name string) {
+	from, err := parseAddress(AddressFlag)
+	common.IfExit(err)
+	owner, err := namereg.Owner(core.EthClient, RegistrarFlag, name)
+	common.IfExit(err)
+	switch {
+	case owner == nil:
+		common.Exit(fmt.Errorf("%s is not reserved (use ethtx name reserve)", name))
+	case !bytes.Equal(owner, from):
+		common.Exit(fmt.Errorf("%s is owned by %s, not --addr", name, utils.ChecksumAddress(owner)))
+	}
+}
+
+// call the registrar. Nothing is sent with the call unless --amt is given
+func processNameTx(data []byte) {
+	amt := AmtFlag
+	if amt == "" {
+		amt = "0"
+	}
+	tx, err := core.Call(AddressFlag, RegistrarFlag, amt, GasFlag, GasPriceFlag, fmt.Sprintf("0x%x", data), NonceFlag)
+	common.IfExit(err)
+	processTx(tx)
+}
+
+func parseAddress(s string) ([]byte, error) {
+	if s == "" {
+		return nil, fmt.Errorf("--addr must be given")
+	}
+	return utils.ParseAddress(resolveAddr(s))
 }
@@ -0,0 +1,247 @@
+package core
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+
+	"github.com/eris-ltd/eth-client/utils"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+)
+
+//---------------------------------------------------------------
+// unsigned tx bundles
+//
+// A bundle is a json file of unsigned txs to be reviewed and signed
+// offline (eg. on an air-gapped machine) and broadcast later.
+// Each entry carries the serialized unsigned tx along with its fields in
+// human readable form. The serialized tx is what gets signed; the
+// readable fields must have the same values or the entry is rejected.
+
+const BundleVersion = 1
+
+type Bundle struct {
+	Version      int         `json:"version"`
+	Transactions []*BundleTx `json:"transactions"`
+}
+
+type BundleTx struct {
+	From        string            `json:"from"`
+	ChainID     string            `json:"chainId"` // decimal, empty if not replay protected
+	Type        string            `json:"type"`
+	Nonce       uint64            `json:"nonce"`
+	To          string            `json:"to"` // empty for contract creation
+	Value       string            `json:"value"`
+	Gas         string            `json:"gas"`
+	GasPrice    string            `json:"gasPrice,omitempty"`
+	MaxFee      string            `json:"maxFeePerGas,omitempty"`
+	PriorityFee string            `json:"maxPriorityFeePerGas,omitempty"`
+	Data        string            `json:"data"`
+	AccessList  []accessTupleJSON `json:"accessList,omitempty"`
+
+	Unsigned string `json:"unsigned"`         // hex serialized unsigned tx
+	Signed   string `json:"signed,omitempty"` // hex serialized signed tx, once signed
+	Hash     string `json:"hash,omitempty"`   // hash of the signed tx
+}
+
+// The names of the tx types, as taken by --type
+var TxTypeNames = map[byte]string{
+	LegacyTxType:     "legacy",
+	AccessListTxType: "access-list",
+	DynamicFeeTxType: "dynamic-fee",
+}
+
+// Make a bundle entry for an unsigned tx
+func NewBundleTx(tx Tx) (*BundleTx, error) {
+	if tx.Signed() {
+		return nil, fmt.Errorf("transaction is already signed")
+	}
+	if tx.From() == nil {
+		return nil, fmt.Errorf("from is not set")
+	}
+	b := &BundleTx{
+		From:     utils.ChecksumAddress(tx.From().Bytes()),
+		Type:     TxTypeNames[tx.Type()],
+		Unsigned: fmt.Sprintf("0x%x", tx.Bytes()),
+	}
+	if tx.ChainID() != nil {
+		b.ChainID = tx.ChainID().String()
+	}
+	switch tx := tx.(type) {
+	case *Transaction:
+		b.fill(tx.Nonce, tx.Recipient, tx.Amount.String(), tx.GasLimit.String(), tx.Data, nil)
+		b.GasPrice = tx.Price.String()
+	case *AccessListTx:
+		b.fill(tx.Nonce, tx.Recipient, tx.Amount.String(), tx.GasLimit.String(), tx.Data, tx.AccessList)
+		b.GasPrice = tx.Price.String()
+	case *DynamicFeeTx:
+		b.fill(tx.Nonce, tx.Recipient, tx.Amount.String(), tx.GasLimit.String(), tx.Data, tx.AccessList)
+		b.MaxFee = tx.MaxFee.String()
+		b.PriorityFee = tx.PriorityFee.String()
+	default:
+		return nil, fmt.Errorf("unknown tx type %T", tx)
+	}
+	return b, nil
+}
+
+func (b *BundleTx) fill(nonce uint64, to *common.Address, value, gas string, data []byte, al AccessList) {
+	b.Nonce = nonce
+	if to != nil {
+		b.To = utils.ChecksumAddress(to.Bytes())
+	}
+	b.Value = value
+	b.Gas = gas
+	b.Data = fmt.Sprintf("0x%x", data)
+	if len(al) > 0 {
+		b.AccessList = al.toJSON()
+	}
+}
+
+// Decode the unsigned tx and check that it matches
+// the readable fields. The returned tx has its from set
+func (b *BundleTx) Tx() (Tx, error) {
+	txBytes, err := hex.DecodeString(utils.StripHex(b.Unsigned))
+	if err != nil {
+		return nil, fmt.Errorf("unsigned tx is bad hex: %v", err)
+	}
+	tx, err := DecodeTx(txBytes)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode unsigned tx: %v", err)
+	}
+	fromBytes, err := utils.ParseAddress(b.From)
+	if err != nil {
+		return nil, fmt.Errorf("bad from: %v", err)
+	}
+	tx.SetFrom(common.BytesToAddress(fromBytes))
+
+	expected, err := NewBundleTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := expected.matches(b); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// compare the readable fields (but not the signature) by value, so they
+// may have been rewritten in another form (eg. lower case addresses, hex amounts)
+func (b *BundleTx) matches(other *BundleTx) error {
+	other, err := other.canonical()
+	if err != nil {
+		return err
+	}
+	v1, v2 := reflect.ValueOf(b).Elem(), reflect.ValueOf(other).Elem()
+	for i := 0; i < v1.NumField(); i++ {
+		name := v1.Type().Field(i).Name
+		switch name {
+		case "From", "Unsigned", "Signed", "Hash":
+			continue
+		}
+		if f1, f2 := v1.Field(i).Interface(), v2.Field(i).Interface(); !reflect.DeepEqual(f1, f2) {
+			return fmt.Errorf("bundle field %s is %v but the unsigned tx has %v", name, f2, f1)
+		}
+	}
+	return nil
+}
+
+// a copy of the readable fields in the form NewBundleTx writes them
+func (b *BundleTx) canonical() (*BundleTx, error) {
+	c := *b
+	amounts := []struct {
+		name string
+		s    *string
+	}{
+		{"chainId", &c.ChainID},
+		{"value", &c.Value},
+		{"gas", &c.Gas},
+		{"gasPrice", &c.GasPrice},
+		{"maxFeePerGas", &c.MaxFee},
+		{"maxPriorityFeePerGas", &c.PriorityFee},
+	}
+	for _, a := range amounts {
+		if *a.s == "" {
+			continue
+		}
+		x, err := stringToBig(*a.s)
+		if err != nil {
+			return nil, fmt.Errorf("bundle field %s: %v", a.name, err)
+		}
+		*a.s = x.String()
+	}
+	if c.To != "" {
+		to, err := utils.ParseAddress(c.To)
+		if err != nil {
+			return nil, fmt.Errorf("bundle field to: %v", err)
+		}
+		c.To = utils.ChecksumAddress(to)
+	}
+	data, err := hex.DecodeString(utils.StripHex(c.Data))
+	if err != nil {
+		return nil, fmt.Errorf("bundle field data is bad hex: %v", err)
+	}
+	c.Data = fmt.Sprintf("0x%x", data)
+	// an empty list, and tuples without storage keys, are written as NewBundleTx would
+	accessList, err := parseAccessList(c.AccessList)
+	if err != nil {
+		return nil, fmt.Errorf("bundle field accessList: %v", err)
+	}
+	c.AccessList = nil
+	if len(accessList) > 0 {
+		c.AccessList = accessList.toJSON()
+	}
+	return &c, nil
+}
+
+// Fill in the signed tx
+func (b *BundleTx) SetSigned(tx Tx) {
+	b.Signed = fmt.Sprintf("0x%x", tx.Bytes())
+	b.Hash = TxHash(tx).Hex()
+}
+
+func LoadBundle(path string) (*Bundle, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBundle(b)
+}
+
+func ParseBundle(b []byte) (*Bundle, error) {
+	bundle := new(Bundle)
+	if err := json.Unmarshal(b, bundle); err != nil {
+		return nil, fmt.Errorf("bundle is bad json: %v", err)
+	}
+	if bundle.Version != BundleVersion {
+		return nil, fmt.Errorf("unsupported bundle version %d", bundle.Version)
+	}
+	return bundle, nil
+}
+
+// Load the bundle at path, or start a new one if the file doesn't exist
+func LoadOrNewBundle(path string) (*Bundle, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return &Bundle{Version: BundleVersion}, nil
+	}
+	return LoadBundle(path)
+}
+
+func (bundle *Bundle) Bytes() ([]byte, error) {
+	b, err := json.MarshalIndent(bundle, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+func (bundle *Bundle) Write(path string) error {
+	b, err := bundle.Bytes()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
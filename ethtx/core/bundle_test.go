@@ -0,0 +1,65 @@
+package core
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+)
+
+// readable fields can be rewritten in other forms, but not changed
+func TestBundleTxMatches(t *testing.T) {
+	from := common.HexToAddress("0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F")
+	to := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	legacy := NewTransaction(&to, &from, 3, big.NewInt(1000), big.NewInt(21000), big.NewInt(7), []byte{0xab})
+	legacy.SetChainID(big.NewInt(1))
+	accessListTx, err := NewAccessListTx(legacy, AccessList{{Address: to}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name string
+		tx   Tx
+		edit func(m map[string]interface{})
+		ok   bool
+	}{
+		{"unchanged", legacy, func(m map[string]interface{}) {}, true},
+		{"lower case to", legacy, func(m map[string]interface{}) { m["to"] = strings.ToLower(m["to"].(string)) }, true},
+		{"hex amounts", legacy, func(m map[string]interface{}) { m["value"], m["gas"], m["chainId"] = "0x3e8", "0x5208", "0x1" }, true},
+		{"upper case data", legacy, func(m map[string]interface{}) { m["data"] = "0xAB" }, true},
+		{"empty access list", legacy, func(m map[string]interface{}) { m["accessList"] = []interface{}{} }, true},
+		{"tuple without storage keys", accessListTx, func(m map[string]interface{}) {
+			m["accessList"] = []interface{}{map[string]interface{}{"address": strings.ToLower(to.Hex())}}
+		}, true},
+		{"changed value", legacy, func(m map[string]interface{}) { m["value"] = "1001" }, false},
+		{"bad checksum", legacy, func(m map[string]interface{}) { m["to"] = strings.Replace(m["to"].(string), "aA", "Aa", 1) }, false},
+		{"changed to", legacy, func(m map[string]interface{}) { m["to"] = "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359" }, false},
+		{"added access list", legacy, func(m map[string]interface{}) {
+			m["accessList"] = []interface{}{map[string]interface{}{"address": to.Hex()}}
+		}, false},
+	} {
+		tx := test.tx
+		tx.SetFrom(from)
+		b, err := NewBundleTx(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// edit the json, as a person would
+		js, _ := json.Marshal(b)
+		m := make(map[string]interface{})
+		json.Unmarshal(js, &m)
+		test.edit(m)
+		js, _ = json.Marshal(m)
+		edited := new(BundleTx)
+		if err := json.Unmarshal(js, edited); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := edited.Tx(); (err == nil) != test.ok {
+			t.Errorf("%s: got error %v, want ok %v", test.name, err, test.ok)
+		}
+	}
+}
@@ -5,12 +5,13 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math/big"
 	"net/http"
-	"strconv"
-	"strings"
+	"time"
 
+	"github.com/eris-ltd/eth-client/crypto"
 	"github.com/eris-ltd/eth-client/utils"
 
 	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
@@ -28,14 +29,25 @@ var EthClient *utils.Client
 // NOTE: this struct isn't exported from go-ethereum/core/types :(
 type Transaction struct {
 	Nonce           uint64
-	Price, GasLimit *big.Int
+	Price, GasLimit *big.Int        // a nil price is unset (see Typed)
 	Recipient       *common.Address `rlp:"nil"` // nil means contract creation
 	Amount          *big.Int
 	Data            []byte
-	V               byte     // signature
-	R, S            *big.Int // signature
+	V, R, S         *big.Int // signature
+
+	from    *common.Address // for convenience
+	chainID *big.Int        // nil means no replay protection (pre EIP-155)
+}
 
-	from *common.Address // for convenience
+// the fields of a legacy tx in rlp order. An unsigned tx with a chain id
+// is serialized in EIP-155 form, ie. with V=chainID and R=S=0
+type txRLP struct {
+	Nonce           uint64
+	Price, GasLimit *big.Int
+	Recipient       *common.Address `rlp:"nil"`
+	Amount          *big.Int
+	Data            []byte
+	V, R, S         *big.Int
 }
 
 func NewTransaction(to, from *common.Address, nonce uint64, amt, gas, price *big.Int, data []byte) *Transaction {
@@ -48,7 +60,7 @@ func NewTransaction(to, from *common.Address, nonce uint64, amt, gas, price *big
 		Data:      data,
 		Amount:    new(big.Int),
 		GasLimit:  new(big.Int),
-		Price:     new(big.Int),
+		V:         new(big.Int),
 		R:         new(big.Int),
 		S:         new(big.Int),
 		from:      from,
@@ -60,7 +72,7 @@ func NewTransaction(to, from *common.Address, nonce uint64, amt, gas, price *big
 		tx.GasLimit.Set(gas)
 	}
 	if price != nil {
-		tx.Price.Set(price)
+		tx.Price = new(big.Int).Set(price)
 	}
 	return tx
 }
@@ -69,44 +81,130 @@ func (tx *Transaction) Bytes() []byte {
 	return rlpEncode(tx)
 }
 
-// Return the signature as a byte array
+// EncodeRLP implements rlp.Encoder
+func (tx *Transaction) EncodeRLP(w io.Writer) error {
+	enc := &txRLP{
+		Nonce:     tx.Nonce,
+		Price:     tx.Price,
+		GasLimit:  tx.GasLimit,
+		Recipient: tx.Recipient,
+		Amount:    tx.Amount,
+		Data:      tx.Data,
+		V:         tx.V,
+		R:         tx.R,
+		S:         tx.S,
+	}
+	if !tx.Signed() && tx.chainID != nil {
+		enc.V = tx.chainID
+	}
+	return rlp.Encode(w, enc)
+}
+
+// DecodeRLP implements rlp.Decoder.
+// The chain id is recovered from V for signed EIP-155 txs,
+// and from the V=chainID, R=S=0 form for unsigned ones
+func (tx *Transaction) DecodeRLP(s *rlp.Stream) error {
+	dec := new(txRLP)
+	if err := s.Decode(dec); err != nil {
+		return err
+	}
+	tx.Nonce = dec.Nonce
+	tx.Price = dec.Price
+	tx.GasLimit = dec.GasLimit
+	tx.Recipient = dec.Recipient
+	tx.Amount = dec.Amount
+	tx.Data = dec.Data
+	tx.V, tx.R, tx.S = dec.V, dec.R, dec.S
+	tx.chainID = nil
+
+	if !tx.Signed() {
+		if tx.V.Sign() != 0 {
+			tx.chainID = tx.V
+			tx.V = new(big.Int)
+		}
+	} else if tx.V.Cmp(big.NewInt(35)) >= 0 {
+		tx.chainID = new(big.Int).Sub(tx.V, big.NewInt(35))
+		tx.chainID.Rsh(tx.chainID, 1)
+	}
+	return nil
+}
+
+// Set the chain id for EIP-155 replay protection.
+// A nil chain id signs the tx without replay protection.
+// Must be called before Sign
+func (tx *Transaction) SetChainID(chainID *big.Int) {
+	if chainID != nil && chainID.Sign() == 0 {
+		chainID = nil
+	}
+	tx.chainID = chainID
+}
+
+// The address the tx is (or will be) signed by.
+// Nil for decoded txs, until SetFrom is called
+func (tx *Transaction) From() *common.Address {
+	return tx.from
+}
+
+func (tx *Transaction) SetFrom(from common.Address) {
+	tx.from = &from
+}
+
+// The chain id the tx is (or will be) signed for.
+// Returns nil if the tx is not replay protected
+func (tx *Transaction) ChainID() *big.Int {
+	return tx.chainID
+}
+
+// Returns true if the tx has a signature
+func (tx *Transaction) Signed() bool {
+	return tx.R.Sign() != 0 || tx.S.Sign() != 0
+}
+
+// Return the signature as a byte array (R || S || V)
 func (tx *Transaction) Signature() []byte {
-	return append(append(tx.R.Bytes(), tx.S.Bytes()...), tx.V)
+	sig := append(common.LeftPadBytes(tx.R.Bytes(), 32), common.LeftPadBytes(tx.S.Bytes(), 32)...)
+	return append(sig, tx.V.Bytes()...)
 }
 
-// Hash of the transaction for signing
+// Hash of the transaction for signing.
+// With a chain id, this is the EIP-155 hash over (..., chainID, 0, 0)
 func (tx *Transaction) SignBytes() []byte {
-	h := rlpHash([]interface{}{
+	fields := []interface{}{
 		tx.Nonce,
 		tx.Price,
 		tx.GasLimit,
 		tx.Recipient,
 		tx.Amount,
 		tx.Data,
-	})
+	}
+	if tx.chainID != nil {
+		fields = append(fields, tx.chainID, uint(0), uint(0))
+	}
+	h := rlpHash(fields)
 	return h[:]
 }
 
-// Sign the transaction using the keys server
-func (tx *Transaction) Sign(signAddr string) error {
-	if tx.from == nil {
-		return fmt.Errorf("from is not set")
-	}
-	signBytes := fmt.Sprintf("%X", tx.SignBytes())
-	addrHex := fmt.Sprintf("%X", tx.from.Bytes())
-	sig, err := Sign(signBytes, addrHex, signAddr)
-	if err != nil {
-		return err
-	}
-	tx.ApplySignature(sig)
-	return nil
+func (tx *Transaction) Type() byte {
+	return LegacyTxType
 }
 
-// Apply the signature to the transaction
+// Sign the transaction with the given signer (eg. the keys server)
+func (tx *Transaction) Sign(signer Signer) error {
+	return signTx(tx, tx.from, signer)
+}
+
+// Apply the signature to the transaction.
+// The last byte of sig is the recovery id (0 or 1)
 func (tx *Transaction) ApplySignature(sig [65]byte) {
 	tx.R = new(big.Int).SetBytes(sig[:32])
 	tx.S = new(big.Int).SetBytes(sig[32:64])
-	tx.V = sig[64] + 27
+	if tx.chainID != nil {
+		// EIP-155: v = recid + 35 + 2*chainID
+		tx.V = new(big.Int).Lsh(tx.chainID, 1)
+		tx.V.Add(tx.V, big.NewInt(int64(sig[64])+35))
+	} else {
+		tx.V = big.NewInt(int64(sig[64]) + 27)
+	}
 }
 
 // Creates an ethereum address from a create transaction
@@ -115,34 +213,34 @@ func (tx *Transaction) CreateAddress() []byte {
 	if tx.Recipient != nil {
 		return nil
 	}
-	data, _ := rlp.EncodeToBytes([]interface{}{tx.from, tx.Nonce})
-	hw := sha3.NewKeccak256()
-	hw.Write(data)
-	b := hw.Sum(nil)
-	return b[12:]
+	return ContractAddress(tx.from, tx.Nonce)
 }
 
 func (tx *Transaction) String() string {
-	var rec []byte
-	if tx.Recipient != nil {
-		rec = tx.Recipient.Bytes()
+	rec := recipientString(tx.Recipient)
+	var chainID []byte
+	if tx.chainID != nil {
+		chainID = tx.chainID.Bytes()
 	}
 	return fmt.Sprintf(`
 	Nonce: %d,
-	To: %x,
+	To: %s,
 	Amount: %x,
 	GasLimit: %x,
 	GasPrice: %x,
-	Data: %x
-`, tx.Nonce, rec, tx.Amount.Bytes(), tx.GasLimit.Bytes(), tx.Price.Bytes(), tx.Data)
+	Data: %x,
+	ChainID: %x
+`, tx.Nonce, rec, tx.Amount.Bytes(), tx.GasLimit.Bytes(), tx.Price.Bytes(), tx.Data, chainID)
 }
 
 //------------------------------------------------------------------------------------
 // core functions with string args.
-// validates strings and forms transaction
+// validates strings and forms transaction.
+// An empty price is left nil for Typed to fetch from the node, if the tx type
+// has a gas price, and an empty gas is left 0 for FillGas to estimate once the tx is complete
 
-func Send(fromAddr, toAddr, amtS, gasS, priceS string, nonce uint64) (*Transaction, error) {
-	from, nonce, amt, gas, price, err := checkCommon(fromAddr, amtS, gasS, priceS, nonce)
+func Send(fromAddr, toAddr, amtS, gasS, priceS, nonceS string) (*Transaction, error) {
+	from, amt, gas, price, err := checkCommon(fromAddr, amtS, gasS, priceS)
 	if err != nil {
 		return nil, err
 	}
@@ -151,17 +249,21 @@ func Send(fromAddr, toAddr, amtS, gasS, priceS string, nonce uint64) (*Transacti
 		return nil, fmt.Errorf("destination address must be given with --to flag")
 	}
 
-	toAddrBytes, err := hex.DecodeString(utils.StripHex(toAddr))
+	toAddrBytes, err := utils.ParseAddress(toAddr)
 	if err != nil {
-		return nil, fmt.Errorf("toAddr is bad hex: %v", err)
+		return nil, fmt.Errorf("bad --to: %v", err)
 	}
 	to := common.BytesToAddress(toAddrBytes)
 
+	nonce, err := resolveNonce(from, nonceS)
+	if err != nil {
+		return nil, err
+	}
 	return NewTransaction(&to, &from, nonce, amt, gas, price, nil), nil
 }
 
-func Create(fromAddr, amtS, gasS, priceS, data string, nonce uint64) (*Transaction, error) {
-	from, nonce, amt, gas, price, err := checkCommon(fromAddr, amtS, gasS, priceS, nonce)
+func Create(fromAddr, amtS, gasS, priceS, data, nonceS string) (*Transaction, error) {
+	from, amt, gas, price, err := checkCommon(fromAddr, amtS, gasS, priceS)
 	if err != nil {
 		return nil, err
 	}
@@ -170,11 +272,16 @@ func Create(fromAddr, amtS, gasS, priceS, data string, nonce uint64) (*Transacti
 	if err != nil {
 		return nil, fmt.Errorf("data is bad hex: %s", data)
 	}
+
+	nonce, err := resolveNonce(from, nonceS)
+	if err != nil {
+		return nil, err
+	}
 	return NewTransaction(nil, &from, nonce, amt, gas, price, dataBytes), nil
 }
 
-func Call(fromAddr, toAddr, amtS, gasS, priceS, data string, nonce uint64) (*Transaction, error) {
-	from, nonce, amt, gas, price, err := checkCommon(fromAddr, amtS, gasS, priceS, nonce)
+func Call(fromAddr, toAddr, amtS, gasS, priceS, data, nonceS string) (*Transaction, error) {
+	from, amt, gas, price, err := checkCommon(fromAddr, amtS, gasS, priceS)
 	if err != nil {
 		return nil, err
 	}
@@ -183,9 +290,9 @@ func Call(fromAddr, toAddr, amtS, gasS, priceS, data string, nonce uint64) (*Tra
 		return nil, fmt.Errorf("destination address must be given with --to flag")
 	}
 
-	toAddrBytes, err := hex.DecodeString(utils.StripHex(toAddr))
+	toAddrBytes, err := utils.ParseAddress(toAddr)
 	if err != nil {
-		return nil, fmt.Errorf("toAddr is bad hex: %v", err)
+		return nil, fmt.Errorf("bad --to: %v", err)
 	}
 	to := common.BytesToAddress(toAddrBytes)
 
@@ -194,12 +301,127 @@ func Call(fromAddr, toAddr, amtS, gasS, priceS, data string, nonce uint64) (*Tra
 		return nil, fmt.Errorf("data is bad hex: %s", data)
 	}
 
+	nonce, err := resolveNonce(from, nonceS)
+	if err != nil {
+		return nil, err
+	}
 	return NewTransaction(&to, &from, nonce, amt, gas, price, dataBytes), nil
 }
 
+// Convert a legacy tx into the given tx type ("legacy", "access-list" or "dynamic-fee", or 0, 1, 2).
+// A nil gas price is fetched from the node, unless the tx becomes a dynamic fee tx.
+// The fees are only used for dynamic fee txs (and suggested by the node if empty),
+// and the access list (a path to a json file) only for typed txs
+func Typed(tx *Transaction, txTypeS, priorityFeeS, maxFeeS, accessListPath string) (Tx, error) {
+	txType, err := ParseTxType(txTypeS)
+	if err != nil {
+		return nil, err
+	}
+
+	if txType == LegacyTxType && (accessListPath != "" || priorityFeeS != "" || maxFeeS != "") {
+		return nil, fmt.Errorf("legacy transactions do not take an access list or 1559 fees (use --type)")
+	}
+	// an unset gas price is only needed if the tx ends up with one
+	if txType != DynamicFeeTxType && tx.Price == nil {
+		if tx.Price, err = SuggestGasPrice(); err != nil {
+			return nil, err
+		}
+	}
+	if txType == LegacyTxType {
+		return tx, nil
+	}
+
+	var accessList AccessList
+	if accessListPath != "" {
+		if accessList, err = LoadAccessList(accessListPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if txType == AccessListTxType {
+		return NewAccessListTx(tx, accessList)
+	}
+
+	// unset fees are suggested from the fee history
+	var priorityFee, maxFee *big.Int
+	if priorityFeeS != "" {
+		if priorityFee, err = stringToBig(priorityFeeS); err != nil {
+			return nil, fmt.Errorf("bad priority fee: %v", err)
+		}
+	}
+	if maxFeeS != "" {
+		if maxFee, err = stringToBig(maxFeeS); err != nil {
+			return nil, fmt.Errorf("bad max fee: %v", err)
+		}
+	}
+	if priorityFee == nil || maxFee == nil {
+		suggestedPriority, suggestedMax, err := SuggestFees(priorityFee)
+		if err != nil {
+			return nil, err
+		}
+		if maxFee == nil {
+			maxFee = suggestedMax
+		}
+		if priorityFee == nil {
+			// the tip can't be more than the max fee
+			priorityFee = suggestedPriority
+			if priorityFee.Cmp(maxFee) > 0 {
+				priorityFee = maxFee
+			}
+		}
+	}
+	return NewDynamicFeeTx(tx, priorityFee, maxFee, accessList)
+}
+
+func ParseTxType(s string) (byte, error) {
+	switch s {
+	case "", "0", "0x0", "legacy":
+		return LegacyTxType, nil
+	case "1", "0x1", "access-list":
+		return AccessListTxType, nil
+	case "2", "0x2", "dynamic-fee":
+		return DynamicFeeTxType, nil
+	}
+	return 0, fmt.Errorf("unknown tx type %s (must be legacy, access-list or dynamic-fee)", s)
+}
+
 //------------------------------------------------------------------------------------
 // sign and broadcast
 
+// sign any tx type's sign bytes
+func signTx(tx Tx, from *common.Address, signer Signer) error {
+	if from == nil {
+		return fmt.Errorf("from is not set")
+	}
+	sig, err := signer.Sign(tx.SignBytes(), *from)
+	if err != nil {
+		return err
+	}
+	tx.ApplySignature(sig)
+	return verifySignature(tx, *from)
+}
+
+// make sure the signer gave us a usable signature from the right key
+// before anything gets broadcast
+func verifySignature(tx Tx, from common.Address) error {
+	sig, err := RawSignature(tx)
+	if err != nil {
+		return fmt.Errorf("signer returned a bad signature: %v", err)
+	}
+	if !crypto.ValidSignatureValues(sig, true) {
+		return fmt.Errorf("signer returned a bad signature: R or S out of range, or S not normalized to the lower half of the curve order")
+	}
+	recovered, err := crypto.RecoverAddress(tx.SignBytes(), sig)
+	if err != nil {
+		return fmt.Errorf("could not recover signer from signature: %v", err)
+	}
+	if recovered != from {
+		return fmt.Errorf("signature is from the wrong key: expected address %s, recovered %s", utils.ChecksumAddress(from.Bytes()), utils.ChecksumAddress(recovered.Bytes()))
+	}
+	return nil
+}
+
+// Sign with the eris-keys daemon at signRPC
 func Sign(signBytes, signAddr, signRPC string) (sig [65]byte, err error) {
 	args := map[string]string{
 		"msg":  signBytes,
@@ -225,19 +447,19 @@ func Sign(signBytes, signAddr, signRPC string) (sig [65]byte, err error) {
 	}
 	sigBytes, err := hex.DecodeString(sigS)
 	if err != nil {
-		err = fmt.Errorf("sig is bad hex:", err)
+		err = fmt.Errorf("sig is bad hex: %v", err)
+		return
+	}
+	if len(sigBytes) != len(sig) {
+		err = fmt.Errorf("signing daemon returned a %d byte signature, expected %d", len(sigBytes), len(sig))
 		return
 	}
 	copy(sig[:], sigBytes)
 	return
 }
 
-func Broadcast(tx *Transaction) (interface{}, error) {
-	w := new(bytes.Buffer)
-	if err := rlp.Encode(w, tx); err != nil {
-		return nil, err
-	}
-	txHex := fmt.Sprintf("%X", w.Bytes())
+func Broadcast(tx Tx) (interface{}, error) {
+	txHex := fmt.Sprintf("%X", tx.Bytes())
 	logger.Debugln("Broadcasting transaction bytes", txHex)
 	r, err := EthClient.RequestResponse("eth", "sendRawTransaction", txHex)
 	if err != nil {
@@ -261,7 +483,7 @@ func requestResponse(req *http.Request) (string, string, error) {
 		return "", "", err
 	}
 	if resp.StatusCode >= 400 {
-		return "", "", fmt.Errorf(resp.Status)
+		return "", "", fmt.Errorf("%s", resp.Status)
 	}
 	return unpackResponse(resp)
 }
@@ -280,9 +502,26 @@ func unpackResponse(resp *http.Response) (string, string, error) {
 
 //------------------------------------------------------------------------------------
 // sign and broadcast convenience
+
+// These must be set before calling SignAndBroadcast with wait
+var (
+	WaitTimeout              = 5 * time.Minute // 0 means wait forever
+	WaitConfirmations uint64 = 1               // number of blocks including and on top of the tx's block
+	WaitPollInterval         = time.Second
+)
+
+const (
+	TxStatusSuccess = "success"
+	TxStatusFailed  = "failed"
+	TxStatusUnknown = "unknown" // pre-byzantium receipts have no status field
+)
+
 type TxResult struct {
-	BlockHash []byte // all txs get in a block
-	Hash      []byte // all txs get a hash
+	BlockHash   []byte // all txs get in a block
+	BlockNumber uint64
+	Hash        []byte // all txs get a hash
+	GasUsed     uint64
+	Status      string
 
 	// only CallTx
 	Address   []byte // only for new contracts
@@ -293,58 +532,146 @@ type TxResult struct {
 	// can differentiate mempool errors from other
 }
 
-func SignAndBroadcast(signAddr string, tx *Transaction, sign, broadcast, wait bool) (txid string, err error) {
+func SignAndBroadcast(signer Signer, tx Tx, sign, broadcast, wait bool) (txResult *TxResult, err error) {
 	if sign {
-		if err = tx.Sign(signAddr); err != nil {
+		if err = tx.Sign(signer); err != nil {
 			return
 		}
 	}
 
 	if broadcast {
-		/*
-			if wait {
-				var ch chan Msg
-				ch, err = subscribeAndWait(tx, chainID, nodeAddr, inputAddr)
-				if err != nil {
-					return nil, err
-				} else {
-					defer func() {
-						if err != nil {
-							// if broadcast threw an error, just return
-							return
-						}
-						logger.Debugln("Waiting for tx to be committed ...")
-						msg := <-ch
-						if msg.Error != nil {
-							logger.Infof("Encountered error waiting for event: %v\n", msg.Error)
-							err = msg.Error
-						} else {
-							txResult.BlockHash = msg.BlockHash
-							txResult.Return = msg.Value
-							txResult.Exception = msg.Exception
-						}
-					}()
-				}
-			}*/
 		var r interface{}
 		r, err = Broadcast(tx)
 		if err != nil {
-			return "", err
+			return nil, err
+		}
+		txid := r.(string)
+		txResult = &TxResult{
+			Address: tx.CreateAddress(),
+		}
+		txResult.Hash, err = hex.DecodeString(utils.StripHex(txid))
+		if err != nil {
+			return nil, fmt.Errorf("node returned bad tx hash %s: %v", txid, err)
 		}
-		return r.(string), nil
-		/*
-			txResult = &TxResult{
-				Hash: receipt.TxHash,
+
+		if wait {
+			logger.Debugln("Waiting for tx to be committed ...")
+			if err = waitForReceipt(txid, txResult); err != nil {
+				return txResult, err
 			}
-			if tx_, ok := tx.(*types.CallTx); ok {
-				if len(tx_.Address) == 0 {
-					txResult.Address = types.NewContractAddress(tx_.Input.Address, tx_.Input.Sequence)
-				}
-			}*/
+		}
 	}
 	return
 }
 
+// WaitForReceipt polls the node until the tx with the given hash
+// has been mined and WaitConfirmations blocks have been committed
+// (counting the tx's own block). It times out after WaitTimeout
+func WaitForReceipt(txHash string) (*TxResult, error) {
+	txResult := new(TxResult)
+	var err error
+	txResult.Hash, err = hex.DecodeString(utils.StripHex(txHash))
+	if err != nil {
+		return nil, fmt.Errorf("tx hash is bad hex: %v", err)
+	}
+	if err = waitForReceipt(txHash, txResult); err != nil {
+		return nil, err
+	}
+	return txResult, nil
+}
+
+func waitForReceipt(txHash string, txResult *TxResult) error {
+	var deadline <-chan time.Time
+	if WaitTimeout > 0 {
+		deadline = time.After(WaitTimeout)
+	}
+	ticker := time.NewTicker(WaitPollInterval)
+	defer ticker.Stop()
+
+	for {
+		done, err := checkReceipt(txHash, txResult)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-deadline:
+			if txResult.BlockHash != nil {
+				return fmt.Errorf("timed out after %v waiting for %d confirmations of tx %s (mined in block %d)", WaitTimeout, WaitConfirmations, txHash, txResult.BlockNumber)
+			}
+			return fmt.Errorf("timed out after %v waiting for tx %s to be mined", WaitTimeout, txHash)
+		}
+	}
+}
+
+// fetch the receipt and fill in the result.
+// returns true once the tx has enough confirmations
+func checkReceipt(txHash string, txResult *TxResult) (bool, error) {
+	r, err := EthClient.RequestResponse("eth", "getTransactionReceipt", txHash)
+	if err != nil {
+		return false, fmt.Errorf("Error fetching receipt: %v", err)
+	}
+	if r == nil {
+		// not mined yet (or dropped out of the chain in a reorg)
+		txResult.BlockHash = nil
+		return false, nil
+	}
+	receipt, ok := r.(map[string]interface{})
+	if !ok {
+		return false, fmt.Errorf("unexpected receipt from node: %v", r)
+	}
+	if err := fillTxResult(receipt, txResult); err != nil {
+		return false, err
+	}
+
+	if WaitConfirmations <= 1 {
+		return true, nil
+	}
+	r, err = EthClient.RequestResponse("eth", "blockNumber")
+	if err != nil {
+		return false, fmt.Errorf("Error fetching block number: %v", err)
+	}
+	latest := uint64(utils.HexToInt(r.(string)))
+	if latest < txResult.BlockNumber {
+		return false, nil
+	}
+	depth := latest - txResult.BlockNumber + 1
+	logger.Debugf("Tx %s has %d/%d confirmations\n", txHash, depth, WaitConfirmations)
+	return depth >= WaitConfirmations, nil
+}
+
+func fillTxResult(receipt map[string]interface{}, txResult *TxResult) (err error) {
+	blockHash, _ := receipt["blockHash"].(string)
+	if txResult.BlockHash, err = hex.DecodeString(utils.StripHex(blockHash)); err != nil {
+		return fmt.Errorf("receipt has bad block hash %s: %v", blockHash, err)
+	}
+	blockNum, _ := receipt["blockNumber"].(string)
+	txResult.BlockNumber = uint64(utils.HexToInt(blockNum))
+	gasUsed, _ := receipt["gasUsed"].(string)
+	txResult.GasUsed = uint64(utils.HexToInt(gasUsed))
+
+	if addr, ok := receipt["contractAddress"].(string); ok && addr != "" {
+		if txResult.Address, err = hex.DecodeString(utils.StripHex(addr)); err != nil {
+			return fmt.Errorf("receipt has bad contract address %s: %v", addr, err)
+		}
+	}
+
+	switch status, _ := receipt["status"].(string); status {
+	case "":
+		txResult.Status = TxStatusUnknown
+	case "0x0":
+		txResult.Status = TxStatusFailed
+		txResult.Exception = "transaction failed (status 0x0)"
+	default:
+		txResult.Status = TxStatusSuccess
+	}
+	return nil
+}
+
 //------------------------------------------------------------------------------------
 // convenience function
 
@@ -362,8 +689,33 @@ func rlpHash(x interface{}) (h common.Hash) {
 	return h
 }
 
+// The address of a contract created by from with the given nonce
+func ContractAddress(from *common.Address, nonce uint64) []byte {
+	data, _ := rlp.EncodeToBytes([]interface{}{from, nonce})
+	hw := sha3.NewKeccak256()
+	hw.Write(data)
+	b := hw.Sum(nil)
+	return b[12:]
+}
+
+// decode hex (with or without "0x") that must be exactly n bytes long
+func decodeFixedHex(s string, n int) ([]byte, error) {
+	b, err := hex.DecodeString(utils.StripHex(s))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != n {
+		return nil, fmt.Errorf("expected %d bytes, got %d", n, len(b))
+	}
+	return b, nil
+}
+
 // assumes the "0x" has already been clipped
 func hexToBig(s string) (*big.Int, error) {
+	if len(s)%2 == 1 {
+		// nodes return quantities without leading zeros
+		s = "0" + s
+	}
 	b, err := hex.DecodeString(s)
 	if err != nil {
 		return nil, err
@@ -371,32 +723,86 @@ func hexToBig(s string) (*big.Int, error) {
 	return new(big.Int).SetBytes(b), nil
 }
 
-// accepts hex or string encoded integers
+// accepts hex or decimal integers of any size,
+// with units or in scientific notation (see utils.ParseUnits)
 func stringToBig(s string) (*big.Int, error) {
-	if strings.HasPrefix(s, "0x") {
-		return hexToBig(utils.StripHex(s))
+	return utils.ParseUnits(s)
+}
+
+// resolve the nonce, or reserve/fetch it if unset.
+// The empty string means unset, so nonce 0 can be given explicitly.
+// Call this last when crafting a tx, so a reserved nonce isn't wasted on bad input
+func resolveNonce(from common.Address, nonceS string) (uint64, error) {
+	if nonceS == "" {
+		if Nonces != nil {
+			return Nonces.Reserve(from)
+		}
+		return PendingNonce(from)
 	}
-	d, err := strconv.ParseInt(s, 0, 64)
+	n, err := stringToBig(nonceS)
 	if err != nil {
-		return nil, err
+		return 0, fmt.Errorf("bad nonce: %v", err)
+	}
+	if n.BitLen() > 64 {
+		return 0, fmt.Errorf("nonce %s is out of range", nonceS)
 	}
-	return big.NewInt(d), nil
+	return n.Uint64(), nil
 }
 
-// if the nonce is given, the addr is not needed
-func checkCommon(addr, amtS, gasS, priceS string, seq uint64) (from common.Address, nonce uint64, amount, gas, price *big.Int, err error) {
+// resolve the chain id for EIP-155 signing.
+// An empty string fetches the chain id from the node,
+// and "0" means no replay protection (returns nil)
+func ResolveChainID(chainIDS string) (*big.Int, error) {
+	if chainIDS != "" {
+		chainID, err := stringToBig(chainIDS)
+		if err != nil {
+			return nil, fmt.Errorf("bad chain id: %v", err)
+		}
+		if chainID.Sign() == 0 {
+			return nil, nil
+		}
+		return chainID, nil
+	}
+
+	if EthClient.Host == "" {
+		// NOTE this error only applies to ethtx, not other possible consumers of ethtx/core
+		return nil, fmt.Errorf("input must specify a chain id with the --chain-id flag or use --node-addr (or ETHTX_NODE_ADDR) to fetch it from a node")
+	}
+	r, err := EthClient.RequestResponse("eth", "chainId")
+	if err != nil {
+		return nil, fmt.Errorf("Error fetching chain id: %v (use --chain-id to set it, or --chain-id=0 to sign without replay protection)", err)
+	}
+	chainIDS, _ = r.(string)
+	chainID, err := hexToBig(utils.StripHex(chainIDS))
+	if err != nil {
+		return nil, fmt.Errorf("node returned bad chain id %v: %v", r, err)
+	}
+	if chainID.Sign() == 0 {
+		return nil, nil
+	}
+	return chainID, nil
+}
+
+// parse the args every tx takes (the price and gas may be left unset)
+func checkCommon(addr, amtS, gasS, priceS string) (from common.Address, amount, gas, price *big.Int, err error) {
 	// resolve the big ints
 	if amount, err = stringToBig(amtS); err != nil {
-		err = fmt.Errorf("amt %s is bad hex: %v", amtS, err)
+		err = fmt.Errorf("bad amt: %v", err)
 		return
 	}
-	if gas, err = stringToBig(gasS); err != nil {
-		err = fmt.Errorf("gas %s is bad hex: %v", gasS, err)
-		return
+	// an empty gas is left unset, to be estimated once the tx is complete (see FillGas)
+	if gasS != "" {
+		if gas, err = stringToBig(gasS); err != nil {
+			err = fmt.Errorf("bad gas: %v", err)
+			return
+		}
 	}
-	if price, err = stringToBig(priceS); err != nil {
-		err = fmt.Errorf("price %s is bad hex: %v", priceS, err)
-		return
+	// likewise an empty price, which dynamic fee txs don't need (see Typed)
+	if priceS != "" {
+		if price, err = stringToBig(priceS); err != nil {
+			err = fmt.Errorf("bad price: %v", err)
+			return
+		}
 	}
 
 	// resolve the address
@@ -405,41 +811,12 @@ func checkCommon(addr, amtS, gasS, priceS string, seq uint64) (from common.Addre
 		return
 	}
 	var addrBytes []byte
-	addrBytes, err = hex.DecodeString(utils.StripHex(addr))
+	addrBytes, err = utils.ParseAddress(addr)
 	if err != nil {
-		err = fmt.Errorf("addr is bad hex: %v", err)
+		err = fmt.Errorf("bad --addr: %v", err)
 		return
 	}
 	from = common.BytesToAddress(addrBytes)
 
-	// resolve the nonce (or fetch it)
-	if seq == 0 {
-		if EthClient.Host == "" {
-			// NOTE this error only applies to ethtx, not other possible consumers of ethtx/core
-			err = fmt.Errorf("input must specify a nonce with the --nonce flag or use --node-addr (or ETHTX_NODE_ADDR) to fetch the nonce from a node")
-			return
-		}
-
-		var r interface{}
-		// fetch block num
-		r, err = EthClient.RequestResponse("eth", "blockNumber")
-		if err != nil {
-			err = fmt.Errorf("Error fetching block number: %v", err)
-			return
-		}
-		// NOTE: both block num and account nonces are hex. (why?!)
-		blockNum := utils.HexToInt(r.(string))
-
-		r, err = EthClient.RequestResponse("eth", "getTransactionCount", addr, blockNum)
-		if err != nil {
-			err = fmt.Errorf("Error fetching account nonce: %v", err)
-			return
-		}
-
-		nonce = uint64(utils.HexToInt(r.(string)))
-	} else {
-		nonce = seq
-	}
-
 	return
 }
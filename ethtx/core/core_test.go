@@ -0,0 +1,101 @@
+package core
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+)
+
+// the example from EIP-155
+func TestEIP155(t *testing.T) {
+	to := common.HexToAddress("0x3535353535353535353535353535353535353535")
+	amt, _ := new(big.Int).SetString("1000000000000000000", 10)
+	tx := NewTransaction(&to, nil, 9, amt, big.NewInt(21000), big.NewInt(20000000000), nil)
+	tx.SetChainID(big.NewInt(1))
+
+	signing := "ec098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a764000080018080"
+	if got := hex.EncodeToString(rlpEncode(tx)); got != signing {
+		t.Errorf("unsigned tx is %s, want %s", got, signing)
+	}
+	hash := "daf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53"
+	if got := hex.EncodeToString(tx.SignBytes()); got != hash {
+		t.Errorf("signing hash is %s, want %s", got, hash)
+	}
+
+	// the spec's signature by the key 0x4646...46, with v = 37
+	var sig [65]byte
+	copy(sig[:32], common.FromHex("28ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276"))
+	copy(sig[32:64], common.FromHex("67cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"))
+	tx.ApplySignature(sig)
+	signed := "f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"
+	if got := hex.EncodeToString(tx.Bytes()); got != signed {
+		t.Fatalf("signed tx is %s, want %s", got, signed)
+	}
+
+	decoded, err := DecodeTx(common.FromHex(signed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.ChainID().Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("decoded chain id is %v, want 1", decoded.ChainID())
+	}
+	sender, err := Sender(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from := common.HexToAddress("0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F"); sender != from {
+		t.Errorf("sender is %x, want %x", sender, from)
+	}
+}
+
+// legacy txs round trip through their serialization, with and without replay protection
+func TestLegacyRoundTrip(t *testing.T) {
+	to := common.HexToAddress("0x3535353535353535353535353535353535353535")
+	for _, test := range []struct {
+		chainID   *big.Int
+		recipient *common.Address
+		signed    string // by the key 0x4646...46
+	}{
+		{nil, &to, "f8620307830186a0943535353535353535353535353535353535353535058260801ca0b611155738b8e8f940a308bab0cb1d177c2ecf8119b02c5b8d156945366d5d2ea019aa6593241071e67c7ac0a48f27a46c8dc44f2415e51e4f6a99575f9d6f2c40"},
+		{nil, nil, "f84e0307830186a080058260801ba00fa0ca15204372575035ada7843ac4cd90a67962098a06873f9a4f047e25c1b4a0154ea4d2d2f2fd1bb232cb4c1c6e996094627becb878389d9cbf509ba58be121"},
+		{big.NewInt(1), &to, "f8620307830186a09435353535353535353535353535353535353535350582608026a09e811638884ec3fea0a0b6ebe224ac9b245d6c5422339b0e91e220ca1d517676a05b621e0388fd3ce073063097476217166d1829dd45bac9b0346e2ea2b85425c6"},
+		{big.NewInt(1), nil, "f84e0307830186a0800582608025a0037bd7dda077817a2693e1c2ce1d76941b46d8308aba90eac502235baf5b855fa02d3467c58c145fa96d6b10fb58b6e98ea9e2a16d58e4f5882aa14c81c642a665"},
+		{big.NewInt(1337), &to, "f8640307830186a094353535353535353535353535353535353535353505826080820a95a0abef8ca1fae335a710c1137d6197eda7f4bcc8cadaa51e906c7451ac4e5ca133a06b9eb158cce56c85e839cdd7816c789249b067b64a436e499b94a5207e1b6c6a"},
+		{big.NewInt(1337), nil, "f8500307830186a08005826080820a95a0ae99671e3a2078aec344b176e8dd96f4ca7b0bd8f8db992522916ddd17fe41d5a03dd56f782106899766b0060dd181f6178d2904e1ee9cec7f0cca2d1a3176ca23"},
+	} {
+		tx := NewTransaction(test.recipient, nil, 3, big.NewInt(5), big.NewInt(100000), big.NewInt(7), []byte{0x60, 0x80})
+		tx.SetChainID(test.chainID)
+
+		// unsigned, as in a bundle
+		decoded, err := DecodeTx(tx.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(decoded.SignBytes(), tx.SignBytes()) {
+			t.Errorf("chain id %v: decoded unsigned tx has a different signing hash", test.chainID)
+		}
+
+		if decoded, err = DecodeTx(common.FromHex(test.signed)); err != nil {
+			t.Fatal(err)
+		}
+		if got := hex.EncodeToString(decoded.Bytes()); got != test.signed {
+			t.Errorf("chain id %v: tx %s round trips as %s", test.chainID, test.signed, got)
+		}
+		if !bytes.Equal(decoded.SignBytes(), tx.SignBytes()) {
+			t.Errorf("chain id %v: decoded tx has a different signing hash", test.chainID)
+		}
+		if (decoded.ChainID() == nil) != (test.chainID == nil) || (test.chainID != nil && decoded.ChainID().Cmp(test.chainID) != 0) {
+			t.Errorf("decoded chain id is %v, want %v", decoded.ChainID(), test.chainID)
+		}
+		sender, err := Sender(decoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if from := common.HexToAddress("0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F"); sender != from {
+			t.Errorf("chain id %v: sender is %x, want %x", test.chainID, sender, from)
+		}
+	}
+}
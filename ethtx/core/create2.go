@@ -0,0 +1,85 @@
+package core
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/eris-ltd/eth-client/crypto"
+	"github.com/eris-ltd/eth-client/utils"
+)
+
+//---------------------------------------------------------------
+// deterministic deployment (CREATE2)
+//
+// A contract is deployed with CREATE2 by calling a factory, which creates it
+// from the salt and init code it's called with. The deterministic deployment
+// proxy takes the 32 byte salt followed by the init code, and returns the
+// 20 byte address of the contract. It's at the same address (crypto.DeploymentProxy)
+// on every chain it's deployed to, so contracts deployed through it are too.
+
+// A call to the factory that deploys the init code with the salt, and the address it
+// will be deployed at. Fails if the factory isn't there, or the address is already taken
+func Create2(fromAddr, factoryAddr, amtS, gasS, priceS string, salt, initCode []byte, nonceS string) (*Transaction, []byte, error) {
+	factory, err := utils.ParseAddress(factoryAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("bad factory: %v", err)
+	}
+	if len(salt) != 32 {
+		return nil, nil, fmt.Errorf("salt must be 32 bytes, got %d", len(salt))
+	}
+	code, err := GetCode(factory, "latest")
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(code) == 0 {
+		return nil, nil, fmt.Errorf("there's no factory contract at %s on this chain (the deterministic deployment proxy must be deployed first)", utils.ChecksumAddress(factory))
+	}
+
+	addr := crypto.Create2Address(factory, salt, initCode)
+	if code, err = GetCode(addr, "latest"); err != nil {
+		return nil, nil, err
+	}
+	if len(code) > 0 {
+		return nil, nil, fmt.Errorf("a contract is already deployed at %s (use another salt)", utils.ChecksumAddress(addr))
+	}
+
+	data := fmt.Sprintf("0x%x%x", salt, initCode)
+	tx, err := Call(fromAddr, utils.ChecksumAddress(factory), amtS, gasS, priceS, data, nonceS)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tx, addr, nil
+}
+
+// Check a factory returned the address the contract was expected at.
+// The proxy returns the bare 20 bytes, other factories the abi encoded address
+func CheckCreate2Return(addr, ret []byte) error {
+	var got []byte
+	switch len(ret) {
+	case 20:
+		got = ret
+	case 32:
+		got = ret[12:]
+	default:
+		return fmt.Errorf("factory returned 0x%x, not an address (did the deployment fail?)", ret)
+	}
+	if !bytes.Equal(got, addr) {
+		return fmt.Errorf("factory would deploy to %s, not the predicted %s", utils.ChecksumAddress(got), utils.ChecksumAddress(addr))
+	}
+	return nil
+}
+
+// The code of the contract at the address, at the block ("latest", "pending", ...)
+func GetCode(addr []byte, block string) ([]byte, error) {
+	r, err := EthClient.RequestResponse("eth", "getCode", hexBytes(addr), block)
+	if err != nil {
+		return nil, fmt.Errorf("Error fetching code of %s: %v", utils.ChecksumAddress(addr), err)
+	}
+	s, _ := r.(string)
+	code, err := hex.DecodeString(utils.StripHex(s))
+	if err != nil {
+		return nil, fmt.Errorf("node returned bad code %v", r)
+	}
+	return code, nil
+}
@@ -0,0 +1,248 @@
+package core
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/eris-ltd/eth-client/utils"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+)
+
+//---------------------------------------------------------------
+// gas and fees
+//
+// Txs crafted without a gas limit or gas price get them from the node:
+// the limit from eth_estimateGas (with a safety margin), the price from
+// eth_gasPrice, and 1559 fees from recent blocks' eth_feeHistory.
+
+var (
+	GasMultiplier = 1.2 // margin on estimated gas
+
+	FeeHistoryBlocks = 10
+	FeePercentile    = 50.0 // of the priority fees paid in recent blocks
+
+	// caps: crafting a tx that would pay more fails (nil for no cap)
+	MaxGas   *big.Int
+	MaxPrice *big.Int // gas price, or max fee for dynamic fee txs
+)
+
+// The json-rpc call object for a tx, as taken by eth_estimateGas and eth_call.
+// An unset (0) gas limit is left out
+func CallArgs(tx Tx) map[string]interface{} {
+	args := make(map[string]interface{})
+	if tx.From() != nil {
+		args["from"] = hexBytes(tx.From().Bytes())
+	}
+	var to *common.Address
+	var amount, gasLimit *big.Int
+	var data []byte
+	var al AccessList
+	switch tx := tx.(type) {
+	case *Transaction:
+		to, amount, gasLimit, data = tx.Recipient, tx.Amount, tx.GasLimit, tx.Data
+		setBigArg(args, "gasPrice", tx.Price)
+	case *AccessListTx:
+		to, amount, gasLimit, data, al = tx.Recipient, tx.Amount, tx.GasLimit, tx.Data, tx.AccessList
+		setBigArg(args, "gasPrice", tx.Price)
+	case *DynamicFeeTx:
+		to, amount, gasLimit, data, al = tx.Recipient, tx.Amount, tx.GasLimit, tx.Data, tx.AccessList
+		setBigArg(args, "maxFeePerGas", tx.MaxFee)
+		setBigArg(args, "maxPriorityFeePerGas", tx.PriorityFee)
+	}
+	if to != nil {
+		args["to"] = hexBytes(to.Bytes())
+	}
+	setBigArg(args, "value", amount)
+	if gasLimit != nil && gasLimit.Sign() != 0 {
+		setBigArg(args, "gas", gasLimit)
+	}
+	args["data"] = hexBytes(data)
+	if tx.Type() != LegacyTxType {
+		args["accessList"] = al.toJSON()
+	}
+	return args
+}
+
+func setBigArg(args map[string]interface{}, name string, x *big.Int) {
+	if x != nil {
+		args[name] = fmt.Sprintf("0x%x", x)
+	}
+}
+
+func hexBytes(b []byte) string {
+	return fmt.Sprintf("0x%x", b)
+}
+
+// The gas limit of a tx
+func GasLimit(tx Tx) *big.Int {
+	switch tx := tx.(type) {
+	case *Transaction:
+		return tx.GasLimit
+	case *AccessListTx:
+		return tx.GasLimit
+	case *DynamicFeeTx:
+		return tx.GasLimit
+	}
+	return nil
+}
+
+func setGasLimit(tx Tx, gas *big.Int) {
+	switch tx := tx.(type) {
+	case *Transaction:
+		tx.GasLimit = gas
+	case *AccessListTx:
+		tx.GasLimit = gas
+	case *DynamicFeeTx:
+		tx.GasLimit = gas
+	}
+}
+
+// The most a tx can pay per gas: the gas price, or the max fee for dynamic fee txs
+func GasPrice(tx Tx) *big.Int {
+	switch tx := tx.(type) {
+	case *Transaction:
+		return tx.Price
+	case *AccessListTx:
+		return tx.Price
+	case *DynamicFeeTx:
+		return tx.MaxFee
+	}
+	return nil
+}
+
+// The most a tx can cost its sender: gas limit * price + amount
+func MaxCost(tx Tx) *big.Int {
+	cost := new(big.Int).Mul(GasLimit(tx), GasPrice(tx))
+	switch tx := tx.(type) {
+	case *Transaction:
+		cost.Add(cost, tx.Amount)
+	case *AccessListTx:
+		cost.Add(cost, tx.Amount)
+	case *DynamicFeeTx:
+		cost.Add(cost, tx.Amount)
+	}
+	return cost
+}
+
+// Ask the node how much gas the tx needs
+func EstimateGas(tx Tx) (*big.Int, error) {
+	r, err := EthClient.RequestResponse("eth", "estimateGas", CallArgs(tx))
+	if err != nil {
+		return nil, fmt.Errorf("Error estimating gas: %v (use --gas to set it)", err)
+	}
+	return resultToBig(r, "gas estimate")
+}
+
+// If the tx has no gas limit (nil or 0, which is never enough),
+// set it to the node's estimate times GasMultiplier.
+// Returns the estimate, or nil if the tx already had a gas limit
+func FillGas(tx Tx) (estimate *big.Int, err error) {
+	if gas := GasLimit(tx); gas != nil && gas.Sign() != 0 {
+		return nil, nil
+	}
+	if GasMultiplier < 1 {
+		return nil, fmt.Errorf("gas multiplier %v would leave less gas than estimated", GasMultiplier)
+	}
+	if estimate, err = EstimateGas(tx); err != nil {
+		return nil, err
+	}
+	// multiply in thousandths to stay exact
+	gas := new(big.Int).Mul(estimate, big.NewInt(int64(GasMultiplier*1000+0.5)))
+	gas.Div(gas, big.NewInt(1000))
+	setGasLimit(tx, gas)
+	return estimate, nil
+}
+
+// Check the tx's gas and price against MaxGas and MaxPrice
+func CheckCaps(tx Tx) error {
+	if gas := GasLimit(tx); MaxGas != nil && gas.Cmp(MaxGas) > 0 {
+		return fmt.Errorf("gas limit %v is above --max-gas %v", gas, MaxGas)
+	}
+	if price := GasPrice(tx); MaxPrice != nil && price.Cmp(MaxPrice) > 0 {
+		return fmt.Errorf("gas price %v is above --max-price %v", price, MaxPrice)
+	}
+	return nil
+}
+
+// The node's suggested gas price for legacy and access list txs
+func SuggestGasPrice() (*big.Int, error) {
+	r, err := EthClient.RequestResponse("eth", "gasPrice")
+	if err != nil {
+		return nil, fmt.Errorf("Error fetching gas price: %v (use --price to set it)", err)
+	}
+	return resultToBig(r, "gas price")
+}
+
+// Suggest 1559 fees from the last FeeHistoryBlocks blocks: the priority fee is the median
+// of the FeePercentile priority fees they paid, and the max fee leaves room for the
+// base fee to double. A given priority fee is kept (pass nil to suggest one)
+func SuggestFees(priorityFee *big.Int) (priority, maxFee *big.Int, err error) {
+	r, err := EthClient.RequestResponse("eth", "feeHistory", fmt.Sprintf("0x%x", FeeHistoryBlocks), "latest", []float64{FeePercentile})
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error fetching fee history: %v (use --max-fee and --priority-fee to set the fees)", err)
+	}
+	history, ok := r.(map[string]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("node returned bad fee history %v", r)
+	}
+
+	// the last base fee is for the next block
+	baseFees, _ := history["baseFeePerGas"].([]interface{})
+	if len(baseFees) == 0 {
+		return nil, nil, fmt.Errorf("node returned no base fees (does the chain support EIP-1559?)")
+	}
+	baseFee, err := resultToBig(baseFees[len(baseFees)-1], "base fee")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	priority = priorityFee
+	if priority == nil {
+		if priority, err = medianReward(history["reward"]); err != nil {
+			return nil, nil, err
+		}
+	}
+	maxFee = new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), priority)
+	return priority, maxFee, nil
+}
+
+func medianReward(r interface{}) (*big.Int, error) {
+	blocks, _ := r.([]interface{})
+	var rewards []*big.Int
+	for _, b := range blocks {
+		percentiles, _ := b.([]interface{})
+		if len(percentiles) == 0 {
+			continue
+		}
+		reward, err := resultToBig(percentiles[0], "priority fee")
+		if err != nil {
+			return nil, err
+		}
+		rewards = append(rewards, reward)
+	}
+	if len(rewards) == 0 {
+		// no recent txs to learn from
+		r, err := EthClient.RequestResponse("eth", "maxPriorityFeePerGas")
+		if err != nil {
+			return nil, fmt.Errorf("Error fetching priority fee: %v (use --priority-fee to set it)", err)
+		}
+		return resultToBig(r, "priority fee")
+	}
+	sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+	return rewards[len(rewards)/2], nil
+}
+
+// parse a hex quantity returned by the node
+func resultToBig(r interface{}, name string) (*big.Int, error) {
+	s, ok := r.(string)
+	if !ok {
+		return nil, fmt.Errorf("node returned bad %s %v", name, r)
+	}
+	x, err := hexToBig(utils.StripHex(s))
+	if err != nil {
+		return nil, fmt.Errorf("node returned bad %s %s: %v", name, s, err)
+	}
+	return x, nil
+}
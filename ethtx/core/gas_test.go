@@ -0,0 +1,145 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eris-ltd/eth-client/utils"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+)
+
+func TestFillGas(t *testing.T) {
+	// a node whose gas estimate is always the same
+	estimate, estimates := 33333, 0
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct{ Method string }
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Method != "eth_estimateGas" {
+			t.Errorf("unexpected call to %s", req.Method)
+		}
+		estimates++
+		fmt.Fprintf(w, `{"jsonrpc": "2.0", "id": "", "result": "0x%x"}`, estimate)
+	}))
+	defer node.Close()
+	defer func(c *utils.Client, m float64) { EthClient, GasMultiplier = c, m }(EthClient, GasMultiplier)
+	EthClient = utils.NewClient(node.URL)
+
+	to := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	for _, test := range []struct {
+		multiplier float64
+		gas        *big.Int // nil or 0 is estimated
+		want       int64    // 0 means an error
+	}{
+		{1.2, nil, 39999}, // 39999.6, rounded down
+		{1.2, big.NewInt(0), 39999},
+		{1.5, nil, 49999},
+		{1, nil, 33333},
+		{1.0006, nil, 33366}, // thousandths, rounded to the nearest
+		{0.9, nil, 0},
+		{0.9, big.NewInt(21000), 21000}, // given gas isn't touched
+	} {
+		GasMultiplier, estimates = test.multiplier, 0
+		tx := NewTransaction(&to, nil, 0, nil, test.gas, big.NewInt(1), nil)
+		if test.gas == nil {
+			tx.GasLimit = nil
+		}
+		est, err := FillGas(tx)
+		switch {
+		case test.want == 0:
+			if err == nil {
+				t.Errorf("multiplier %v: gas set to %v", test.multiplier, tx.GasLimit)
+			}
+		case err != nil:
+			t.Errorf("multiplier %v: %v", test.multiplier, err)
+		case tx.GasLimit.Int64() != test.want:
+			t.Errorf("multiplier %v: gas set to %v, want %d", test.multiplier, tx.GasLimit, test.want)
+		case test.gas != nil && test.gas.Sign() != 0:
+			if est != nil || estimates != 0 {
+				t.Errorf("gas %v was estimated", test.gas)
+			}
+		case est == nil || est.Int64() != int64(estimate) || estimates != 1:
+			t.Errorf("multiplier %v: returned estimate %v after %d estimates", test.multiplier, est, estimates)
+		}
+	}
+}
+
+func TestCheckCaps(t *testing.T) {
+	defer func(gas, price *big.Int) { MaxGas, MaxPrice = gas, price }(MaxGas, MaxPrice)
+
+	to := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	legacy := NewTransaction(&to, nil, 0, nil, big.NewInt(21000), big.NewInt(20e9), nil)
+	legacy.SetChainID(big.NewInt(1))
+	dynamic, err := NewDynamicFeeTx(legacy, big.NewInt(2e9), big.NewInt(30e9), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		tx               Tx
+		maxGas, maxPrice *big.Int
+		ok               bool
+	}{
+		{legacy, nil, nil, true},
+		{legacy, big.NewInt(21000), big.NewInt(20e9), true},
+		{legacy, big.NewInt(20999), nil, false},
+		{legacy, nil, big.NewInt(20e9 - 1), false},
+		{dynamic, nil, big.NewInt(30e9), true},
+		{dynamic, nil, big.NewInt(25e9), false}, // the max fee counts, not the priority fee
+		{dynamic, big.NewInt(20000), nil, false},
+	} {
+		MaxGas, MaxPrice = test.maxGas, test.maxPrice
+		if err := CheckCaps(test.tx); (err == nil) != test.ok {
+			t.Errorf("%T under max gas %v and max price %v: got error %v, want ok %v", test.tx, test.maxGas, test.maxPrice, err, test.ok)
+		}
+	}
+}
+
+// only txs that end up with a gas price ask the node for one
+func TestTypedGasPrice(t *testing.T) {
+	calls := make(map[string]int)
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct{ Method string }
+		json.NewDecoder(r.Body).Decode(&req)
+		calls[req.Method]++
+		switch req.Method {
+		case "eth_gasPrice":
+			fmt.Fprint(w, `{"jsonrpc": "2.0", "id": "", "result": "0x3b9aca00"}`)
+		case "eth_feeHistory":
+			fmt.Fprint(w, `{"jsonrpc": "2.0", "id": "", "result": {"baseFeePerGas": ["0x64", "0x64"], "reward": [["0xa"]]}}`)
+		}
+	}))
+	defer node.Close()
+	defer func(c *utils.Client) { EthClient = c }(EthClient)
+	EthClient = utils.NewClient(node.URL)
+
+	to := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	for _, test := range []struct {
+		txType    string
+		price     *big.Int
+		wantPrice int64
+		wantCalls int
+	}{
+		{"legacy", nil, 1e9, 1},
+		{"legacy", big.NewInt(0), 0, 0},
+		{"access-list", nil, 1e9, 1},
+		{"access-list", big.NewInt(7), 7, 0},
+		{"dynamic-fee", nil, 200 + 10, 0},
+	} {
+		calls = make(map[string]int)
+		legacy := NewTransaction(&to, nil, 0, nil, nil, test.price, nil)
+		legacy.SetChainID(big.NewInt(1))
+		tx, err := Typed(legacy, test.txType, "", "", "")
+		if err != nil {
+			t.Errorf("%s: %v", test.txType, err)
+			continue
+		}
+		if price := GasPrice(tx); price.Int64() != test.wantPrice || calls["eth_gasPrice"] != test.wantCalls {
+			t.Errorf("%s with price %v: price %v after %d eth_gasPrice calls, want %d after %d",
+				test.txType, test.price, price, calls["eth_gasPrice"], test.wantPrice, test.wantCalls)
+		}
+	}
+}
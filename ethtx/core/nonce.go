@@ -0,0 +1,199 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+)
+
+//---------------------------------------------------------------
+// nonce management
+//
+// The node only knows about txs it has seen, so two ethtx invocations
+// (or two txs in one bundle) can pick the same nonce before either is broadcast.
+// The NonceManager keeps the next nonce for each address in a local file,
+// and hands out max(local, pending) under a lock so concurrent callers don't collide.
+
+// If set, txs crafted without an explicit nonce reserve one here.
+// Otherwise the nonce is the node's pending tx count
+var Nonces *NonceManager
+
+var (
+	NonceLockTimeout = 10 * time.Second
+	NonceLockStale   = 30 * time.Second // locks older than this were left by a dead process
+)
+
+type NonceManager struct {
+	Dir string // one file per address, holding the next nonce (decimal)
+}
+
+func NewNonceManager(dir string) *NonceManager {
+	return &NonceManager{dir}
+}
+
+func (nm *NonceManager) path(addr common.Address) string {
+	return filepath.Join(nm.Dir, fmt.Sprintf("%x", addr.Bytes()))
+}
+
+// The next nonce stored locally for addr. ok is false if there is none
+func (nm *NonceManager) Local(addr common.Address) (nonce uint64, ok bool, err error) {
+	b, err := ioutil.ReadFile(nm.path(addr))
+	if os.IsNotExist(err) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+	nonce, err = strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("nonce file %s is corrupt: %v", nm.path(addr), err)
+	}
+	return nonce, true, nil
+}
+
+// Reserve the next nonce for addr: the larger of the local and the node's pending nonce.
+// The local nonce is advanced past it
+func (nm *NonceManager) Reserve(addr common.Address) (nonce uint64, err error) {
+	pending, err := PendingNonce(addr)
+	if err != nil {
+		return 0, err
+	}
+	err = nm.withLock(addr, func() error {
+		local, ok, err := nm.Local(addr)
+		if err != nil {
+			return err
+		}
+		nonce = pending
+		if ok && local > pending {
+			logger.Infof("Local nonce %d is ahead of the node's pending nonce %d (if those txs were dropped, run ethtx nonce resync)\n", local, pending)
+			nonce = local
+		}
+		return nm.write(addr, nonce+1)
+	})
+	return
+}
+
+// Give back a reserved nonce that was never broadcast.
+// Only the most recent reservation can be given back
+func (nm *NonceManager) Release(addr common.Address, nonce uint64) error {
+	return nm.withLock(addr, func() error {
+		local, ok, err := nm.Local(addr)
+		if err != nil || !ok || local != nonce+1 {
+			return err
+		}
+		return nm.write(addr, nonce)
+	})
+}
+
+// Record that a tx with the given nonce was broadcast
+// (eg. with an explicit --nonce), so the local nonce never falls behind it
+func (nm *NonceManager) Used(addr common.Address, nonce uint64) error {
+	return nm.withLock(addr, func() error {
+		local, ok, err := nm.Local(addr)
+		if err != nil || (ok && local > nonce) {
+			return err
+		}
+		return nm.write(addr, nonce+1)
+	})
+}
+
+// Set the local nonce for addr
+func (nm *NonceManager) Set(addr common.Address, nonce uint64) error {
+	return nm.withLock(addr, func() error {
+		return nm.write(addr, nonce)
+	})
+}
+
+// Forget the local nonce for addr, so the node's pending nonce is used
+func (nm *NonceManager) Reset(addr common.Address) error {
+	return nm.withLock(addr, func() error {
+		err := os.Remove(nm.path(addr))
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	})
+}
+
+// Set the local nonce for addr to the node's pending nonce
+func (nm *NonceManager) Resync(addr common.Address) (uint64, error) {
+	pending, err := PendingNonce(addr)
+	if err != nil {
+		return 0, err
+	}
+	return pending, nm.Set(addr, pending)
+}
+
+// write via a temp file so readers never see a partial nonce
+func (nm *NonceManager) write(addr common.Address, nonce uint64) error {
+	tmp := nm.path(addr) + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(fmt.Sprintf("%d\n", nonce)), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, nm.path(addr))
+}
+
+// run f while holding the lock file for addr.
+// The lock is a file created exclusively, so it works across processes (and platforms)
+func (nm *NonceManager) withLock(addr common.Address, f func() error) error {
+	if err := os.MkdirAll(nm.Dir, 0700); err != nil {
+		return err
+	}
+	lock := nm.path(addr) + ".lock"
+	start := time.Now()
+	for {
+		lf, err := os.OpenFile(lock, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			fmt.Fprintf(lf, "%d\n", os.Getpid())
+			lf.Close()
+			break
+		}
+		if !os.IsExist(err) {
+			return err
+		}
+		if info, err := os.Stat(lock); err == nil && time.Since(info.ModTime()) > NonceLockStale {
+			logger.Warnf("Removing stale nonce lock %s\n", lock)
+			os.Remove(lock)
+			continue
+		}
+		if time.Since(start) > NonceLockTimeout {
+			return fmt.Errorf("timed out waiting for nonce lock %s (remove it if no other ethtx is running)", lock)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	defer os.Remove(lock)
+	return f()
+}
+
+// The node's nonce for addr, counting txs in its pool
+func PendingNonce(addr common.Address) (uint64, error) {
+	return fetchNonce(addr, "pending")
+}
+
+// The node's nonce for addr, counting only mined txs
+func LatestNonce(addr common.Address) (uint64, error) {
+	return fetchNonce(addr, "latest")
+}
+
+func fetchNonce(addr common.Address, tag string) (uint64, error) {
+	if EthClient.Host == "" {
+		// NOTE this error only applies to ethtx, not other possible consumers of ethtx/core
+		return 0, fmt.Errorf("input must specify a nonce with the --nonce flag or use --node-addr (or ETHTX_NODE_ADDR) to fetch the nonce from a node")
+	}
+	r, err := EthClient.RequestResponse("eth", "getTransactionCount", fmt.Sprintf("0x%x", addr.Bytes()), tag)
+	if err != nil {
+		return 0, fmt.Errorf("Error fetching account nonce: %v", err)
+	}
+	// NOTE: account nonces are hex
+	s, _ := r.(string)
+	nonce, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return 0, fmt.Errorf("node returned bad nonce %v: %v", r, err)
+	}
+	return nonce, nil
+}
@@ -0,0 +1,167 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eris-ltd/eth-client/utils"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+)
+
+// a nonce manager in a temp dir, with a node whose pending nonce is *pending
+func testNonces(t *testing.T, pending *uint64) (nm *NonceManager, cleanup func()) {
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"jsonrpc": "2.0", "id": "", "result": "0x%x"}`, atomic.LoadUint64(pending))
+	}))
+	dir, err := ioutil.TempDir("", "nonces")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := EthClient
+	EthClient = utils.NewClient(node.URL)
+	return NewNonceManager(dir), func() {
+		EthClient = client
+		node.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func reserve(t *testing.T, nm *NonceManager, addr common.Address, want uint64) {
+	nonce, err := nm.Reserve(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nonce != want {
+		t.Errorf("reserved nonce %d, want %d", nonce, want)
+	}
+}
+
+func TestNonceReserve(t *testing.T) {
+	pending := uint64(5)
+	nm, cleanup := testNonces(t, &pending)
+	defer cleanup()
+	addr := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	// the local nonce is ahead of the node's, until the node catches up
+	reserve(t, nm, addr, 5)
+	reserve(t, nm, addr, 6)
+	atomic.StoreUint64(&pending, 10)
+	reserve(t, nm, addr, 10)
+	atomic.StoreUint64(&pending, 3)
+	reserve(t, nm, addr, 11)
+	if local, ok, err := nm.Local(addr); err != nil || !ok || local != 12 {
+		t.Errorf("local nonce is %d (%v, %v), want 12", local, ok, err)
+	}
+
+	// other addresses have their own
+	reserve(t, nm, common.HexToAddress("0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"), 3)
+}
+
+func TestNonceRelease(t *testing.T) {
+	pending := uint64(5)
+	nm, cleanup := testNonces(t, &pending)
+	defer cleanup()
+	addr := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	reserve(t, nm, addr, 5)
+	reserve(t, nm, addr, 6)
+	// 5 isn't the most recent, so giving it back would reuse 6
+	if err := nm.Release(addr, 5); err != nil {
+		t.Fatal(err)
+	}
+	reserve(t, nm, addr, 7)
+	if err := nm.Release(addr, 7); err != nil {
+		t.Fatal(err)
+	}
+	reserve(t, nm, addr, 7)
+}
+
+func TestNonceUsed(t *testing.T) {
+	pending := uint64(0)
+	nm, cleanup := testNonces(t, &pending)
+	defer cleanup()
+	addr := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	for _, test := range []struct {
+		used, local uint64
+	}{
+		{4, 5},
+		{2, 5}, // never backwards
+		{5, 6},
+		{9, 10},
+	} {
+		if err := nm.Used(addr, test.used); err != nil {
+			t.Fatal(err)
+		}
+		if local, _, err := nm.Local(addr); err != nil || local != test.local {
+			t.Errorf("after using %d, local nonce is %d (%v), want %d", test.used, local, err, test.local)
+		}
+	}
+}
+
+func TestNonceLock(t *testing.T) {
+	pending := uint64(0)
+	nm, cleanup := testNonces(t, &pending)
+	defer cleanup()
+	addr := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	defer func(timeout time.Duration) { NonceLockTimeout = timeout }(NonceLockTimeout)
+	NonceLockTimeout = 200 * time.Millisecond
+
+	// a live lock is waited for
+	lock := filepath.Join(nm.Dir, "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed.lock")
+	if err := ioutil.WriteFile(lock, []byte("1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if nonce, err := nm.Reserve(addr); err == nil {
+		t.Errorf("reserved %d while the lock was held", nonce)
+	}
+
+	// a dead process's lock is removed
+	old := time.Now().Add(-2 * NonceLockStale)
+	if err := os.Chtimes(lock, old, old); err != nil {
+		t.Fatal(err)
+	}
+	reserve(t, nm, addr, 0)
+	if _, err := os.Stat(lock); !os.IsNotExist(err) {
+		t.Errorf("lock is left after reserving: %v", err)
+	}
+}
+
+func TestNonceConcurrentReserve(t *testing.T) {
+	pending := uint64(5)
+	nm, cleanup := testNonces(t, &pending)
+	defer cleanup()
+	addr := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	const n = 10
+	nonces := make([]int, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			nonce, err := nm.Reserve(addr)
+			if err != nil {
+				t.Error(err)
+			}
+			nonces[i] = int(nonce)
+		}(i)
+	}
+	wg.Wait()
+	sort.Ints(nonces)
+	for i, nonce := range nonces {
+		if nonce != 5+i {
+			t.Fatalf("reserved nonces %v, want 5 to %d", nonces, 5+n-1)
+		}
+	}
+}
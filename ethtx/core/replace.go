@@ -0,0 +1,286 @@
+package core
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/eris-ltd/eth-client/utils"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+)
+
+//---------------------------------------------------------------
+// replacing pending txs
+//
+// A pending tx is replaced by another from the same sender with the same nonce.
+// Nodes only take the replacement if it pays enough more than the original:
+// geth's txpool wants the gas price, or both the max fee and the priority fee
+// of dynamic fee txs, raised by at least 10% (its --txpool.pricebump).
+
+// The percent a replacement's fees must be above the original's
+var PriceBump int64 = 10
+
+// gas for a plain transfer, as used by a cancel
+var transferGas = big.NewInt(21000)
+
+// a tx as returned by eth_getTransactionByHash
+type rpcTx struct {
+	Type                 string            `json:"type"`
+	BlockNumber          *string           `json:"blockNumber"`
+	From                 string            `json:"from"`
+	To                   *string           `json:"to"`
+	Nonce                string            `json:"nonce"`
+	Value                string            `json:"value"`
+	Gas                  string            `json:"gas"`
+	GasPrice             string            `json:"gasPrice"`
+	MaxFeePerGas         string            `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas string            `json:"maxPriorityFeePerGas"`
+	Input                string            `json:"input"`
+	AccessList           []accessTupleJSON `json:"accessList"`
+	ChainID              string            `json:"chainId"`
+	V                    string            `json:"v"`
+}
+
+// Fetch a pending tx and rebuild it, unsigned, to be replaced.
+// Fails if the node doesn't know it, or it's already mined
+func PendingTx(txHash string) (Tx, error) {
+	if _, err := decodeFixedHex(txHash, len(common.Hash{})); err != nil {
+		return nil, fmt.Errorf("bad tx hash %s: %v", txHash, err)
+	}
+	r, err := EthClient.RequestResponse("eth", "getTransactionByHash", txHash)
+	if err != nil {
+		return nil, fmt.Errorf("Error fetching tx %s: %v", txHash, err)
+	}
+	if r == nil {
+		return nil, fmt.Errorf("tx %s is unknown to the node (was it dropped, or never broadcast?)", txHash)
+	}
+	// the client has already decoded the result, so go around again
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	var rtx rpcTx
+	if err := json.Unmarshal(b, &rtx); err != nil {
+		return nil, fmt.Errorf("node returned bad tx %s: %v", txHash, err)
+	}
+	if rtx.BlockNumber != nil {
+		return nil, fmt.Errorf("tx %s is already mined in block %d, it can't be replaced", txHash, utils.HexToInt(*rtx.BlockNumber))
+	}
+
+	tx, err := rtx.toTx()
+	if err != nil {
+		return nil, fmt.Errorf("node returned bad tx %s: %v", txHash, err)
+	}
+
+	// another tx with the same nonce may have been mined since
+	latest, err := LatestNonce(*tx.From())
+	if err != nil {
+		return nil, err
+	}
+	if nonce := TxNonce(tx); latest > nonce {
+		return nil, fmt.Errorf("nonce %d of %s is already used by a mined tx, so tx %s can't be mined or replaced", nonce, utils.ChecksumAddress(tx.From().Bytes()), txHash)
+	}
+	return tx, nil
+}
+
+func (rtx *rpcTx) toTx() (Tx, error) {
+	txType, err := ParseTxType(rtx.Type)
+	if err != nil {
+		return nil, err
+	}
+	fromBytes, err := utils.ParseAddress(rtx.From)
+	if err != nil {
+		return nil, fmt.Errorf("bad from: %v", err)
+	}
+	from := common.BytesToAddress(fromBytes)
+	var to *common.Address
+	if rtx.To != nil {
+		toBytes, err := utils.ParseAddress(*rtx.To)
+		if err != nil {
+			return nil, fmt.Errorf("bad to: %v", err)
+		}
+		addr := common.BytesToAddress(toBytes)
+		to = &addr
+	}
+	nonce, err := quantity(rtx.Nonce, "nonce")
+	if err != nil {
+		return nil, err
+	}
+	if nonce.BitLen() > 64 {
+		return nil, fmt.Errorf("nonce %s is out of range", rtx.Nonce)
+	}
+	amount, err := quantity(rtx.Value, "value")
+	if err != nil {
+		return nil, err
+	}
+	gas, err := quantity(rtx.Gas, "gas")
+	if err != nil {
+		return nil, err
+	}
+	data, err := hex.DecodeString(utils.StripHex(rtx.Input))
+	if err != nil {
+		return nil, fmt.Errorf("bad input: %v", err)
+	}
+	chainID, err := rtx.chainID()
+	if err != nil {
+		return nil, err
+	}
+
+	var price *big.Int
+	if txType != DynamicFeeTxType {
+		if price, err = quantity(rtx.GasPrice, "gas price"); err != nil {
+			return nil, err
+		}
+	}
+	tx := NewTransaction(to, &from, nonce.Uint64(), amount, gas, price, data)
+	tx.SetChainID(chainID)
+	if txType == LegacyTxType {
+		return tx, nil
+	}
+
+	accessList, err := parseAccessList(rtx.AccessList)
+	if err != nil {
+		return nil, err
+	}
+	if txType == AccessListTxType {
+		return NewAccessListTx(tx, accessList)
+	}
+	maxFee, err := quantity(rtx.MaxFeePerGas, "max fee")
+	if err != nil {
+		return nil, err
+	}
+	priorityFee, err := quantity(rtx.MaxPriorityFeePerGas, "priority fee")
+	if err != nil {
+		return nil, err
+	}
+	return NewDynamicFeeTx(tx, priorityFee, maxFee, accessList)
+}
+
+// the chain id, which nodes leave out of legacy txs, where it's in v (EIP-155)
+func (rtx *rpcTx) chainID() (*big.Int, error) {
+	if rtx.ChainID != "" {
+		return quantity(rtx.ChainID, "chain id")
+	}
+	v, err := quantity(rtx.V, "v")
+	if err != nil {
+		return nil, err
+	}
+	if v.Cmp(big.NewInt(35)) < 0 {
+		// not replay protected
+		return nil, nil
+	}
+	return v.Sub(v, big.NewInt(35)).Rsh(v, 1), nil
+}
+
+func quantity(s, name string) (*big.Int, error) {
+	if s == "" {
+		return nil, fmt.Errorf("no %s", name)
+	}
+	x, err := hexToBig(utils.StripHex(s))
+	if err != nil {
+		return nil, fmt.Errorf("bad %s %s: %v", name, s, err)
+	}
+	return x, nil
+}
+
+// A tx that cancels the given one: a zero value transfer from
+// the sender to itself, with the same nonce and type
+func CancelTx(tx Tx) (Tx, error) {
+	from := tx.From()
+	cancel := NewTransaction(from, from, TxNonce(tx), nil, transferGas, GasPrice(tx), nil)
+	cancel.SetChainID(tx.ChainID())
+	switch tx := tx.(type) {
+	case *AccessListTx:
+		return NewAccessListTx(cancel, nil)
+	case *DynamicFeeTx:
+		return NewDynamicFeeTx(cancel, tx.PriorityFee, tx.MaxFee, nil)
+	}
+	return cancel, nil
+}
+
+// Raise the fees of a replacement (still holding the original's fees) to what
+// nodes accept, or to the node's current suggestion if that's more.
+// Fees that are given (non nil) are used instead, but must be high enough
+func BumpFees(tx Tx, price, priorityFee, maxFee *big.Int) error {
+	if PriceBump < 0 {
+		return fmt.Errorf("price bump %d%% must not be negative", PriceBump)
+	}
+	dtx, ok := tx.(*DynamicFeeTx)
+	if !ok {
+		if priorityFee != nil || maxFee != nil {
+			return fmt.Errorf("the original is not a dynamic fee tx, so its replacement takes a gas price, not 1559 fees (use --price)")
+		}
+		min := bumped(GasPrice(tx))
+		if price == nil {
+			suggested, err := SuggestGasPrice()
+			if err != nil {
+				return err
+			}
+			price = maxBig(min, suggested)
+		} else if price.Cmp(min) < 0 {
+			return fmt.Errorf("gas price %v is too low to replace the original's %v (must be at least %v, %d%% more)", price, GasPrice(tx), min, PriceBump)
+		}
+		setGasPrice(tx, price)
+		return nil
+	}
+
+	if price != nil {
+		return fmt.Errorf("the original is a dynamic fee tx, so its replacement takes 1559 fees, not a gas price (use --max-fee and --priority-fee)")
+	}
+	minPriority, minMax := bumped(dtx.PriorityFee), bumped(dtx.MaxFee)
+	if priorityFee == nil || maxFee == nil {
+		suggestedPriority, suggestedMax, err := SuggestFees(priorityFee)
+		if err != nil {
+			return err
+		}
+		if priorityFee == nil {
+			priorityFee = maxBig(minPriority, suggestedPriority)
+		}
+		if maxFee == nil {
+			maxFee = maxBig(minMax, suggestedMax)
+			// the tip can't be more than the max fee
+			maxFee = maxBig(maxFee, priorityFee)
+		}
+	}
+	if priorityFee.Cmp(minPriority) < 0 {
+		return fmt.Errorf("priority fee %v is too low to replace the original's %v (must be at least %v, %d%% more)", priorityFee, dtx.PriorityFee, minPriority, PriceBump)
+	}
+	if maxFee.Cmp(minMax) < 0 {
+		return fmt.Errorf("max fee %v is too low to replace the original's %v (must be at least %v, %d%% more)", maxFee, dtx.MaxFee, minMax, PriceBump)
+	}
+	if priorityFee.Cmp(maxFee) > 0 {
+		return fmt.Errorf("priority fee (%v) must not exceed the max fee (%v)", priorityFee, maxFee)
+	}
+	dtx.PriorityFee, dtx.MaxFee = new(big.Int).Set(priorityFee), new(big.Int).Set(maxFee)
+	return nil
+}
+
+// the least a replacement can pay: PriceBump percent more, rounded up,
+// and always more than the original
+func bumped(x *big.Int) *big.Int {
+	min := new(big.Int).Mul(x, big.NewInt(100+PriceBump))
+	min.Add(min, big.NewInt(99))
+	min.Div(min, big.NewInt(100))
+	if min.Cmp(x) <= 0 {
+		min.Add(x, big.NewInt(1))
+	}
+	return min
+}
+
+func maxBig(x, y *big.Int) *big.Int {
+	if x.Cmp(y) > 0 {
+		return x
+	}
+	return y
+}
+
+func setGasPrice(tx Tx, price *big.Int) {
+	switch tx := tx.(type) {
+	case *Transaction:
+		tx.Price = new(big.Int).Set(price)
+	case *AccessListTx:
+		tx.Price = new(big.Int).Set(price)
+	}
+}
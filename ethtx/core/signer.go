@@ -0,0 +1,88 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/eris-ltd/eth-client/crypto"
+	"github.com/eris-ltd/eth-client/hdwallet"
+	"github.com/eris-ltd/eth-client/utils"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+)
+
+//---------------------------------------------------------------
+// signers
+
+// A Signer signs 32 byte hashes on behalf of an address.
+// Signatures are R || S || recovery id (0 or 1)
+type Signer interface {
+	Sign(hash []byte, addr common.Address) ([65]byte, error)
+}
+
+// KeysSigner signs with the eris-keys daemon
+type KeysSigner struct {
+	Host string // eg. http://localhost:4767
+}
+
+func NewKeysSigner(host string) *KeysSigner {
+	return &KeysSigner{host}
+}
+
+func (s *KeysSigner) Sign(hash []byte, addr common.Address) ([65]byte, error) {
+	return Sign(fmt.Sprintf("%X", hash), fmt.Sprintf("%X", addr.Bytes()), s.Host)
+}
+
+// KeystoreSigner signs in process with keys from a directory
+// of v3 keystore files (eg. geth's keystore)
+type KeystoreSigner struct {
+	Dir string
+
+	// called once per address to get the passphrase for its key
+	Passphrase func(addr common.Address) (string, error)
+
+	keys map[common.Address]*crypto.Key
+}
+
+func NewKeystoreSigner(dir string, passphrase func(common.Address) (string, error)) *KeystoreSigner {
+	return &KeystoreSigner{
+		Dir:        dir,
+		Passphrase: passphrase,
+		keys:       make(map[common.Address]*crypto.Key),
+	}
+}
+
+func (s *KeystoreSigner) Sign(hash []byte, addr common.Address) (sig [65]byte, err error) {
+	key, ok := s.keys[addr]
+	if !ok {
+		if _, err = crypto.FindKeyFile(s.Dir, addr); err != nil {
+			return
+		}
+		var pass string
+		if pass, err = s.Passphrase(addr); err != nil {
+			return
+		}
+		if key, err = crypto.LoadKey(s.Dir, addr, pass); err != nil {
+			return
+		}
+		s.keys[addr] = key
+	}
+	logger.Debugf("Signing %X with keystore key for %s\n", hash, utils.ChecksumAddress(addr.Bytes()))
+	return crypto.Sign(hash, key.PrivateKey)
+}
+
+// HDSigner signs in process with a key derived from a mnemonic (BIP-39/32/44)
+type HDSigner struct {
+	Account *hdwallet.Account
+}
+
+func NewHDSigner(account *hdwallet.Account) *HDSigner {
+	return &HDSigner{account}
+}
+
+func (s *HDSigner) Sign(hash []byte, addr common.Address) (sig [65]byte, err error) {
+	if addr != s.Account.Address {
+		return sig, fmt.Errorf("%s is not the account at %s of the mnemonic (%s is)", utils.ChecksumAddress(addr.Bytes()), s.Account.Path, utils.ChecksumAddress(s.Account.Address.Bytes()))
+	}
+	logger.Debugf("Signing %X with the key at %s\n", hash, s.Account.Path)
+	return crypto.Sign(hash, s.Account.PrivateKey)
+}
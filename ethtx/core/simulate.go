@@ -0,0 +1,75 @@
+package core
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/eris-ltd/eth-client/abi"
+	"github.com/eris-ltd/eth-client/utils"
+)
+
+//---------------------------------------------------------------
+// dry runs
+//
+// A tx that reverts still pays for the gas it used, so before a tx is
+// broadcast it's run through eth_call against the pending state, with
+// the same from, to, value, data and gas, and the sender's balance is checked.
+// Replacements are run against the latest block instead, since the pending
+// state already has the tx they replace.
+
+// A simulated tx that reverted
+type RevertError struct {
+	Revert *abi.Revert
+}
+
+func (e *RevertError) Error() string {
+	return fmt.Sprintf("transaction would revert: %s", e.Revert)
+}
+
+// Run the tx with eth_call at the block ("pending", "latest", ...) and return what it returns.
+// A revert is returned as a *RevertError, decoded with the contract's
+// custom errors if its abi is given (it may be nil)
+func Simulate(tx Tx, contract *abi.ABI, block string) ([]byte, error) {
+	args := CallArgs(tx)
+	// the balance is checked separately, with a clearer error
+	delete(args, "gasPrice")
+	delete(args, "maxFeePerGas")
+	delete(args, "maxPriorityFeePerGas")
+	r, err := EthClient.RequestResponse("eth", "call", args, block)
+	if rpcErr, ok := err.(*utils.RPCError); ok {
+		// reverts come back as errors with the revert data
+		if data, ok := rpcErr.DataBytes(); ok {
+			return nil, &RevertError{abi.DecodeRevert(data, contract)}
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error simulating the transaction: %v", err)
+	}
+	s, _ := r.(string)
+	ret, err := hex.DecodeString(utils.StripHex(s))
+	if err != nil {
+		return nil, fmt.Errorf("node returned bad call result %v", r)
+	}
+	return ret, nil
+}
+
+// Check the sender's balance at the block covers the most the tx can cost (value + gas * price)
+func CheckBalance(tx Tx, block string) error {
+	from := tx.From()
+	if from == nil {
+		return fmt.Errorf("from is not set")
+	}
+	r, err := EthClient.RequestResponse("eth", "getBalance", hexBytes(from.Bytes()), block)
+	if err != nil {
+		return fmt.Errorf("Error fetching balance: %v", err)
+	}
+	balance, err := resultToBig(r, "balance")
+	if err != nil {
+		return err
+	}
+	if cost := MaxCost(tx); balance.Cmp(cost) < 0 {
+		return fmt.Errorf("%s has %s ether, but the transaction can cost up to %s ether (value + gas * price)",
+			utils.ChecksumAddress(from.Bytes()), utils.FormatUnits(balance, utils.EtherDecimals), utils.FormatUnits(cost, utils.EtherDecimals))
+	}
+	return nil
+}
@@ -0,0 +1,540 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/eris-ltd/eth-client/crypto"
+	"github.com/eris-ltd/eth-client/utils"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/crypto/sha3"
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/rlp"
+)
+
+//---------------------------------------------------------------
+// typed transaction envelopes (EIP-2718)
+//
+// A typed transaction is serialized as TxType || rlp(payload)
+// and signed over keccak256(TxType || rlp(payload without signature)).
+// Legacy transactions are plain rlp lists (see Transaction)
+
+const (
+	LegacyTxType     byte = 0x00
+	AccessListTxType byte = 0x01 // EIP-2930
+	DynamicFeeTxType byte = 0x02 // EIP-1559
+)
+
+// Tx is implemented by every transaction type we can craft
+type Tx interface {
+	Type() byte
+	Bytes() []byte     // serialized tx (with the type prefix for typed txs)
+	SignBytes() []byte // hash to be signed
+	Sign(signer Signer) error
+	ApplySignature(sig [65]byte)
+	Signature() []byte
+	Signed() bool
+	ChainID() *big.Int
+	From() *common.Address
+	SetFrom(from common.Address)
+	CreateAddress() []byte
+	String() string
+}
+
+// Decode a serialized transaction of any type
+func DecodeTx(b []byte) (Tx, error) {
+	if len(b) == 0 {
+		return nil, fmt.Errorf("empty transaction")
+	}
+	// legacy txs are rlp lists, which start at 0xc0
+	if b[0] >= 0xc0 {
+		tx := new(Transaction)
+		if err := rlp.DecodeBytes(b, tx); err != nil {
+			return nil, err
+		}
+		return tx, nil
+	}
+	switch b[0] {
+	case AccessListTxType:
+		tx := new(AccessListTx)
+		if err := tx.decode(b[1:]); err != nil {
+			return nil, err
+		}
+		return tx, nil
+	case DynamicFeeTxType:
+		tx := new(DynamicFeeTx)
+		if err := tx.decode(b[1:]); err != nil {
+			return nil, err
+		}
+		return tx, nil
+	}
+	return nil, fmt.Errorf("unknown transaction type 0x%02x", b[0])
+}
+
+// The hash that identifies the tx on chain
+func TxHash(tx Tx) common.Hash {
+	return common.BytesToHash(crypto.Keccak256(tx.Bytes()))
+}
+
+func TxNonce(tx Tx) uint64 {
+	switch tx := tx.(type) {
+	case *Transaction:
+		return tx.Nonce
+	case *AccessListTx:
+		return tx.Nonce
+	case *DynamicFeeTx:
+		return tx.Nonce
+	}
+	return 0
+}
+
+// The tx's recipient (nil for contract creation)
+func TxRecipient(tx Tx) *common.Address {
+	switch tx := tx.(type) {
+	case *Transaction:
+		return tx.Recipient
+	case *AccessListTx:
+		return tx.Recipient
+	case *DynamicFeeTx:
+		return tx.Recipient
+	}
+	return nil
+}
+
+// The tx's signature in R || S || recovery id form.
+// Fails if V is not valid for the tx type (and chain id)
+func RawSignature(tx Tx) (sig [65]byte, err error) {
+	var v, r, s *big.Int
+	switch tx := tx.(type) {
+	case *Transaction:
+		r, s = tx.R, tx.S
+		// strip the EIP-155 or pre-155 offset
+		v = new(big.Int).Sub(tx.V, big.NewInt(27))
+		if tx.chainID != nil {
+			v = new(big.Int).Lsh(tx.chainID, 1)
+			v.Sub(tx.V, v.Add(v, big.NewInt(35)))
+		}
+	case *AccessListTx:
+		v, r, s = tx.V, tx.R, tx.S
+	case *DynamicFeeTx:
+		v, r, s = tx.V, tx.R, tx.S
+	default:
+		return sig, fmt.Errorf("unknown tx type %T", tx)
+	}
+	if v.Sign() < 0 || v.Cmp(big.NewInt(1)) > 0 {
+		return sig, fmt.Errorf("invalid signature V for tx type 0x%02x", tx.Type())
+	}
+	if r.BitLen() > 256 || s.BitLen() > 256 {
+		return sig, fmt.Errorf("signature R or S is more than 32 bytes")
+	}
+	copy(sig[:32], common.LeftPadBytes(r.Bytes(), 32))
+	copy(sig[32:64], common.LeftPadBytes(s.Bytes(), 32))
+	sig[64] = byte(v.Uint64())
+	return sig, nil
+}
+
+// Recover the address that signed the tx
+func Sender(tx Tx) (common.Address, error) {
+	if !tx.Signed() {
+		return common.Address{}, fmt.Errorf("transaction is not signed")
+	}
+	sig, err := RawSignature(tx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if !crypto.ValidSignatureValues(sig, false) {
+		return common.Address{}, fmt.Errorf("signature R or S is out of range")
+	}
+	return crypto.RecoverAddress(tx.SignBytes(), sig)
+}
+
+//---------------------------------------------------------------
+// access lists
+
+type AccessTuple struct {
+	Address     common.Address
+	StorageKeys []common.Hash
+}
+
+type AccessList []AccessTuple
+
+// json form as used by the eth rpc, eg.
+// [{"address": "0x...", "storageKeys": ["0x...", ...]}, ...]
+type accessTupleJSON struct {
+	Address     string   `json:"address"`
+	StorageKeys []string `json:"storageKeys"`
+}
+
+// Load an access list from a json file
+func LoadAccessList(path string) (AccessList, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var tuples []accessTupleJSON
+	if err := json.Unmarshal(b, &tuples); err != nil {
+		return nil, fmt.Errorf("access list %s is bad json: %v", path, err)
+	}
+	return parseAccessList(tuples)
+}
+
+func parseAccessList(tuples []accessTupleJSON) (AccessList, error) {
+	list := make(AccessList, len(tuples))
+	for i, t := range tuples {
+		addr, err := utils.ParseAddress(t.Address)
+		if err != nil {
+			return nil, fmt.Errorf("access list entry %d: %v", i, err)
+		}
+		list[i].Address = common.BytesToAddress(addr)
+		list[i].StorageKeys = make([]common.Hash, len(t.StorageKeys))
+		for j, k := range t.StorageKeys {
+			key, err := decodeFixedHex(k, len(common.Hash{}))
+			if err != nil {
+				return nil, fmt.Errorf("access list entry %d: storage key %s: %v", i, k, err)
+			}
+			list[i].StorageKeys[j] = common.BytesToHash(key)
+		}
+	}
+	return list, nil
+}
+
+func (al AccessList) toJSON() []accessTupleJSON {
+	tuples := make([]accessTupleJSON, len(al))
+	for i, t := range al {
+		tuples[i].Address = utils.ChecksumAddress(t.Address.Bytes())
+		tuples[i].StorageKeys = make([]string, len(t.StorageKeys))
+		for j, k := range t.StorageKeys {
+			tuples[i].StorageKeys[j] = k.Hex()
+		}
+	}
+	return tuples
+}
+
+func (al AccessList) String() string {
+	buf := new(bytes.Buffer)
+	for _, t := range al {
+		fmt.Fprintf(buf, "\n\t\t%s:", utils.ChecksumAddress(t.Address.Bytes()))
+		for _, k := range t.StorageKeys {
+			fmt.Fprintf(buf, " %x", k)
+		}
+	}
+	return buf.String()
+}
+
+//---------------------------------------------------------------
+// EIP-2930 access list transactions
+
+type AccessListTx struct {
+	Nonce           uint64
+	Price, GasLimit *big.Int
+	Recipient       *common.Address // nil means contract creation
+	Amount          *big.Int
+	Data            []byte
+	AccessList      AccessList
+	V, R, S         *big.Int // signature. V is the y parity (0 or 1)
+
+	from    *common.Address
+	chainID *big.Int
+}
+
+type accessListTxRLP struct {
+	ChainID         *big.Int
+	Nonce           uint64
+	Price, GasLimit *big.Int
+	Recipient       *common.Address `rlp:"nil"`
+	Amount          *big.Int
+	Data            []byte
+	AccessList      AccessList
+	V, R, S         *big.Int
+}
+
+// Make an access list tx out of a legacy one.
+// The legacy tx must have a chain id
+func NewAccessListTx(tx *Transaction, accessList AccessList) (*AccessListTx, error) {
+	if tx.chainID == nil {
+		return nil, fmt.Errorf("access list transactions require a chain id")
+	}
+	return &AccessListTx{
+		Nonce:      tx.Nonce,
+		Price:      tx.Price,
+		GasLimit:   tx.GasLimit,
+		Recipient:  tx.Recipient,
+		Amount:     tx.Amount,
+		Data:       tx.Data,
+		AccessList: accessList,
+		V:          new(big.Int),
+		R:          new(big.Int),
+		S:          new(big.Int),
+		from:       tx.from,
+		chainID:    tx.chainID,
+	}, nil
+}
+
+func (tx *AccessListTx) Type() byte                  { return AccessListTxType }
+func (tx *AccessListTx) ChainID() *big.Int           { return tx.chainID }
+func (tx *AccessListTx) Signed() bool                { return tx.R.Sign() != 0 || tx.S.Sign() != 0 }
+func (tx *AccessListTx) Signature() []byte           { return typedSignature(tx.V, tx.R, tx.S) }
+func (tx *AccessListTx) From() *common.Address       { return tx.from }
+func (tx *AccessListTx) SetFrom(from common.Address) { tx.from = &from }
+
+func (tx *AccessListTx) Bytes() []byte {
+	return typedEncode(AccessListTxType, tx.payload())
+}
+
+func (tx *AccessListTx) SignBytes() []byte {
+	p := tx.payload()
+	return typedHash(AccessListTxType, []interface{}{
+		p.ChainID, p.Nonce, p.Price, p.GasLimit, p.Recipient, p.Amount, p.Data, p.AccessList,
+	})
+}
+
+func (tx *AccessListTx) Sign(signer Signer) error {
+	return signTx(tx, tx.from, signer)
+}
+
+func (tx *AccessListTx) ApplySignature(sig [65]byte) {
+	tx.V, tx.R, tx.S = typedSignatureValues(sig)
+}
+
+func (tx *AccessListTx) CreateAddress() []byte {
+	if tx.Recipient != nil {
+		return nil
+	}
+	return ContractAddress(tx.from, tx.Nonce)
+}
+
+func (tx *AccessListTx) String() string {
+	return fmt.Sprintf(`
+	Type: 0x%02x (access list),
+	ChainID: %x,
+	Nonce: %d,
+	To: %s,
+	Amount: %x,
+	GasLimit: %x,
+	GasPrice: %x,
+	Data: %x,
+	AccessList: %v
+`, tx.Type(), tx.chainID.Bytes(), tx.Nonce, recipientString(tx.Recipient), tx.Amount.Bytes(), tx.GasLimit.Bytes(), tx.Price.Bytes(), tx.Data, tx.AccessList)
+}
+
+func (tx *AccessListTx) payload() *accessListTxRLP {
+	return &accessListTxRLP{
+		ChainID:    tx.chainID,
+		Nonce:      tx.Nonce,
+		Price:      tx.Price,
+		GasLimit:   tx.GasLimit,
+		Recipient:  tx.Recipient,
+		Amount:     tx.Amount,
+		Data:       tx.Data,
+		AccessList: tx.AccessList,
+		V:          tx.V,
+		R:          tx.R,
+		S:          tx.S,
+	}
+}
+
+func (tx *AccessListTx) decode(b []byte) error {
+	dec := new(accessListTxRLP)
+	if err := rlp.DecodeBytes(b, dec); err != nil {
+		return err
+	}
+	*tx = AccessListTx{
+		Nonce:      dec.Nonce,
+		Price:      dec.Price,
+		GasLimit:   dec.GasLimit,
+		Recipient:  dec.Recipient,
+		Amount:     dec.Amount,
+		Data:       dec.Data,
+		AccessList: dec.AccessList,
+		V:          dec.V,
+		R:          dec.R,
+		S:          dec.S,
+		chainID:    dec.ChainID,
+	}
+	return nil
+}
+
+//---------------------------------------------------------------
+// EIP-1559 dynamic fee transactions
+
+type DynamicFeeTx struct {
+	Nonce       uint64
+	PriorityFee *big.Int // maxPriorityFeePerGas
+	MaxFee      *big.Int // maxFeePerGas
+	GasLimit    *big.Int
+	Recipient   *common.Address // nil means contract creation
+	Amount      *big.Int
+	Data        []byte
+	AccessList  AccessList
+	V, R, S     *big.Int // signature. V is the y parity (0 or 1)
+
+	from    *common.Address
+	chainID *big.Int
+}
+
+type dynamicFeeTxRLP struct {
+	ChainID     *big.Int
+	Nonce       uint64
+	PriorityFee *big.Int
+	MaxFee      *big.Int
+	GasLimit    *big.Int
+	Recipient   *common.Address `rlp:"nil"`
+	Amount      *big.Int
+	Data        []byte
+	AccessList  AccessList
+	V, R, S     *big.Int
+}
+
+// Make a dynamic fee tx out of a legacy one (its gas price is ignored).
+// The legacy tx must have a chain id
+func NewDynamicFeeTx(tx *Transaction, priorityFee, maxFee *big.Int, accessList AccessList) (*DynamicFeeTx, error) {
+	if tx.chainID == nil {
+		return nil, fmt.Errorf("dynamic fee transactions require a chain id")
+	}
+	if priorityFee == nil || maxFee == nil {
+		return nil, fmt.Errorf("dynamic fee transactions require a max fee and a priority fee")
+	}
+	if priorityFee.Cmp(maxFee) > 0 {
+		return nil, fmt.Errorf("priority fee (%v) must not exceed the max fee (%v)", priorityFee, maxFee)
+	}
+	return &DynamicFeeTx{
+		Nonce:       tx.Nonce,
+		PriorityFee: new(big.Int).Set(priorityFee),
+		MaxFee:      new(big.Int).Set(maxFee),
+		GasLimit:    tx.GasLimit,
+		Recipient:   tx.Recipient,
+		Amount:      tx.Amount,
+		Data:        tx.Data,
+		AccessList:  accessList,
+		V:           new(big.Int),
+		R:           new(big.Int),
+		S:           new(big.Int),
+		from:        tx.from,
+		chainID:     tx.chainID,
+	}, nil
+}
+
+func (tx *DynamicFeeTx) Type() byte                  { return DynamicFeeTxType }
+func (tx *DynamicFeeTx) ChainID() *big.Int           { return tx.chainID }
+func (tx *DynamicFeeTx) Signed() bool                { return tx.R.Sign() != 0 || tx.S.Sign() != 0 }
+func (tx *DynamicFeeTx) Signature() []byte           { return typedSignature(tx.V, tx.R, tx.S) }
+func (tx *DynamicFeeTx) From() *common.Address       { return tx.from }
+func (tx *DynamicFeeTx) SetFrom(from common.Address) { tx.from = &from }
+
+func (tx *DynamicFeeTx) Bytes() []byte {
+	return typedEncode(DynamicFeeTxType, tx.payload())
+}
+
+func (tx *DynamicFeeTx) SignBytes() []byte {
+	p := tx.payload()
+	return typedHash(DynamicFeeTxType, []interface{}{
+		p.ChainID, p.Nonce, p.PriorityFee, p.MaxFee, p.GasLimit, p.Recipient, p.Amount, p.Data, p.AccessList,
+	})
+}
+
+func (tx *DynamicFeeTx) Sign(signer Signer) error {
+	return signTx(tx, tx.from, signer)
+}
+
+func (tx *DynamicFeeTx) ApplySignature(sig [65]byte) {
+	tx.V, tx.R, tx.S = typedSignatureValues(sig)
+}
+
+func (tx *DynamicFeeTx) CreateAddress() []byte {
+	if tx.Recipient != nil {
+		return nil
+	}
+	return ContractAddress(tx.from, tx.Nonce)
+}
+
+func (tx *DynamicFeeTx) String() string {
+	return fmt.Sprintf(`
+	Type: 0x%02x (dynamic fee),
+	ChainID: %x,
+	Nonce: %d,
+	To: %s,
+	Amount: %x,
+	GasLimit: %x,
+	MaxFee: %x,
+	PriorityFee: %x,
+	Data: %x,
+	AccessList: %v
+`, tx.Type(), tx.chainID.Bytes(), tx.Nonce, recipientString(tx.Recipient), tx.Amount.Bytes(), tx.GasLimit.Bytes(), tx.MaxFee.Bytes(), tx.PriorityFee.Bytes(), tx.Data, tx.AccessList)
+}
+
+func (tx *DynamicFeeTx) payload() *dynamicFeeTxRLP {
+	return &dynamicFeeTxRLP{
+		ChainID:     tx.chainID,
+		Nonce:       tx.Nonce,
+		PriorityFee: tx.PriorityFee,
+		MaxFee:      tx.MaxFee,
+		GasLimit:    tx.GasLimit,
+		Recipient:   tx.Recipient,
+		Amount:      tx.Amount,
+		Data:        tx.Data,
+		AccessList:  tx.AccessList,
+		V:           tx.V,
+		R:           tx.R,
+		S:           tx.S,
+	}
+}
+
+func (tx *DynamicFeeTx) decode(b []byte) error {
+	dec := new(dynamicFeeTxRLP)
+	if err := rlp.DecodeBytes(b, dec); err != nil {
+		return err
+	}
+	*tx = DynamicFeeTx{
+		Nonce:       dec.Nonce,
+		PriorityFee: dec.PriorityFee,
+		MaxFee:      dec.MaxFee,
+		GasLimit:    dec.GasLimit,
+		Recipient:   dec.Recipient,
+		Amount:      dec.Amount,
+		Data:        dec.Data,
+		AccessList:  dec.AccessList,
+		V:           dec.V,
+		R:           dec.R,
+		S:           dec.S,
+		chainID:     dec.ChainID,
+	}
+	return nil
+}
+
+//---------------------------------------------------------------
+// helpers shared by the typed txs
+
+func typedEncode(txType byte, payload interface{}) []byte {
+	return append([]byte{txType}, rlpEncode(payload)...)
+}
+
+func typedHash(txType byte, fields []interface{}) []byte {
+	hw := sha3.NewKeccak256()
+	hw.Write([]byte{txType})
+	rlp.Encode(hw, fields)
+	return hw.Sum(nil)
+}
+
+// typed txs store the recovery id directly in V
+func typedSignatureValues(sig [65]byte) (v, r, s *big.Int) {
+	r = new(big.Int).SetBytes(sig[:32])
+	s = new(big.Int).SetBytes(sig[32:64])
+	v = big.NewInt(int64(sig[64]))
+	return
+}
+
+func typedSignature(v, r, s *big.Int) []byte {
+	sig := append(common.LeftPadBytes(r.Bytes(), 32), common.LeftPadBytes(s.Bytes(), 32)...)
+	return append(sig, byte(v.Uint64()))
+}
+
+// the checksummed recipient, or "" for contract creations
+func recipientString(to *common.Address) string {
+	if to == nil {
+		return ""
+	}
+	return utils.ChecksumAddress(to.Bytes())
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+)
+
+// typed txs round trip through their serialization, as calls and contract creations
+func TestTypedRoundTrip(t *testing.T) {
+	to := common.HexToAddress("0x3535353535353535353535353535353535353535")
+	accessList := AccessList{{
+		Address:     to,
+		StorageKeys: []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")},
+	}}
+
+	for _, test := range []struct {
+		txType    byte
+		recipient *common.Address
+		signed    string // by the key 0x4646...46
+	}{
+		{AccessListTxType, &to, "01f8c28205390307830186a094353535353535353535353535353535353535353505826080f85bf859943535353535353535353535353535353535353535f842a00000000000000000000000000000000000000000000000000000000000000001a0000000000000000000000000000000000000000000000000000000000000000201a05946db29b96b71095c80de1f0e577a66a05d3219cb5c143279fd7f8a2cae50bca0793ac2fc66119cce694658abd3779fdc63677441dc106f92cf92ffa37ea29d6f"},
+		{AccessListTxType, nil, "01f8ae8205390307830186a08005826080f85bf859943535353535353535353535353535353535353535f842a00000000000000000000000000000000000000000000000000000000000000001a0000000000000000000000000000000000000000000000000000000000000000201a0ac85758b08b5599c1b49d82c14398bcba3bf21334782650190659980c69c7456a009113de74eb9eb8a6e6a64bbb8e962c8fc5a51e5fb2eab0b9ff992ca1ef1d004"},
+		{DynamicFeeTxType, &to, "02f8c382053903021e830186a094353535353535353535353535353535353535353505826080f85bf859943535353535353535353535353535353535353535f842a00000000000000000000000000000000000000000000000000000000000000001a0000000000000000000000000000000000000000000000000000000000000000280a0e5e9b614fbc222ae30949cda79cfcf2e6fce85058eb60dc1449c3e172e5fd324a0294c40b31885dfbf24e097c1b135b6e0801fbc65909831ed5081c2f9fd8a0343"},
+		{DynamicFeeTxType, nil, "02f8af82053903021e830186a08005826080f85bf859943535353535353535353535353535353535353535f842a00000000000000000000000000000000000000000000000000000000000000001a0000000000000000000000000000000000000000000000000000000000000000201a0da6bd8f43e23c0ce9888dbc506cb8f912f5197f3335553e5c85a4ec5300864d6a06fe0e62475da215ed267650cb1f81efd2f66a52d242d694da24245f3dd736f8a"},
+	} {
+		legacy := NewTransaction(test.recipient, nil, 3, big.NewInt(5), big.NewInt(100000), big.NewInt(7), []byte{0x60, 0x80})
+		legacy.SetChainID(big.NewInt(1337))
+		var tx Tx
+		var err error
+		name := "access list tx"
+		if test.txType == AccessListTxType {
+			tx, err = NewAccessListTx(legacy, accessList)
+		} else {
+			tx, err = NewDynamicFeeTx(legacy, big.NewInt(2), big.NewInt(30), accessList)
+			name = "dynamic fee tx"
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if test.recipient == nil {
+			name += " creating a contract"
+		}
+
+		decoded, err := DecodeTx(tx.Bytes())
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !bytes.Equal(decoded.SignBytes(), tx.SignBytes()) {
+			t.Errorf("%s: decoded unsigned tx has a different signing hash", name)
+		}
+
+		if decoded, err = DecodeTx(common.FromHex(test.signed)); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if got := hex.EncodeToString(decoded.Bytes()); decoded.Type() != test.txType || got != test.signed {
+			t.Errorf("%s: %s round trips as type 0x%02x tx %s", name, test.signed, decoded.Type(), got)
+		}
+		if !bytes.Equal(decoded.SignBytes(), tx.SignBytes()) {
+			t.Errorf("%s: decoded tx has a different signing hash", name)
+		}
+		if decoded.ChainID().Cmp(big.NewInt(1337)) != 0 {
+			t.Errorf("%s: decoded chain id is %v, want 1337", name, decoded.ChainID())
+		}
+		sender, err := Sender(decoded)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if from := common.HexToAddress("0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F"); sender != from {
+			t.Errorf("%s: sender is %x, want %x", name, sender, from)
+		}
+	}
+}
+
+func TestDecodeUnknownType(t *testing.T) {
+	if _, err := DecodeTx([]byte{0x03, 0xc0}); err == nil {
+		t.Error("decoded a tx of unknown type 0x03")
+	}
+	if _, err := DecodeTx(nil); err == nil {
+		t.Error("decoded an empty tx")
+	}
+}
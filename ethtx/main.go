@@ -3,10 +3,18 @@ package main
 import (
 	"fmt"
 	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/eris-ltd/eth-client/abi"
+	"github.com/eris-ltd/eth-client/crypto"
 	"github.com/eris-ltd/eth-client/ethtx/core"
+	"github.com/eris-ltd/eth-client/hdwallet"
 	"github.com/eris-ltd/eth-client/utils"
 
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/eris-ltd/common/go/common"
 	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/eris-ltd/common/go/log"
 	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/spf13/cobra"
 )
@@ -22,7 +30,17 @@ var (
 
 	ADDR = ""
 
+	NONCE_DIR = path.Join(common.Usr(), ".eth-client", "nonces")
+
+	REGISTRAR = ""
+
+	ENS_REGISTRY = "" // by chain id if not given
+
 	client *utils.Client
+	signer core.Signer
+
+	// where --output=json writes (stdout, while everything else goes to stderr)
+	jsonOut *os.File
 )
 
 // override the hardcoded defaults with env variables if they're set
@@ -41,40 +59,161 @@ func init() {
 	if addr != "" {
 		ADDR = addr
 	}
+
+	nonceDir := os.Getenv("ETHTX_NONCE_DIR")
+	if nonceDir != "" {
+		NONCE_DIR = nonceDir
+	}
+
+	registrar := os.Getenv("ETHTX_REGISTRAR")
+	if registrar != "" {
+		REGISTRAR = registrar
+	}
+
+	ensRegistry := os.Getenv("ETHTX_ENS_REGISTRY")
+	if ensRegistry != "" {
+		ENS_REGISTRY = ensRegistry
+	}
 }
 
 var (
+	// config file profile
+	ProfileFlag string
+
 	// logging
 	LogLevelFlag int
+	OutputFlag   string
 
 	// all transactions take
-	NonceFlag    uint64
+	NonceFlag    string
 	AmtFlag      string
 	GasFlag      string
 	GasPriceFlag string
 
+	// gas and fees
+	GasMultiplierFlag float64
+	FeePercentileFlag float64
+	MaxGasFlag        string
+	MaxPriceFlag      string
+
+	// typed transactions
+	TxTypeFlag      string
+	MaxFeeFlag      string
+	PriorityFeeFlag string
+	AccessListFlag  string
+
 	// sign/broadcast/wait
 	AddressFlag   string
+	ChainIDFlag   string
 	BinaryFlag    bool
 	SignFlag      bool
 	BroadcastFlag bool
 	WaitFlag      bool
 
+	// dry run before broadcasting
+	SimulateFlag bool
+	ForceFlag    bool
+
+	// wait
+	TimeoutFlag       time.Duration
+	ConfirmationsFlag uint64
+
 	// http addresses
 	HostAddrFlag string
 	SignAddrFlag string
 
+	// local nonce tracking
+	NonceDirFlag string
+
+	// local keystore signing
+	KeystoreFlag     string
+	PasswordFileFlag string
+
+	// hd wallet signing
+	MnemonicFileFlag string
+	HDPathFlag       string
+	CountFlag        int
+
 	// specifics
 	ToFlag   string
 	DataFlag string
+
+	// abi encoded calls
+	AbiFlag    string
+	MethodFlag string
+
+	// contract creation
+	LinkFlags   linkFlag
+	Create2Flag bool
+	SaltFlag    string
+	FactoryFlag string
+
+	// ens
+	ENSRegistryFlag string
+
+	// nfts
+	FromFlag string
+
+	SkipChecksumFlag bool
+
+	// name registrar
+	RegistrarFlag string
+	PrimaryFlag   bool
+
+	// offline signing
+	BundleFlag string
+	OutFlag    string
+
+	// replacing pending txs
+	BumpFlag int64
+
+	// signed messages
+	HexFlag bool
 )
 
+// repeatable --link Name=0xaddr
+type linkFlag map[string]string
+
+func (l *linkFlag) Set(s string) error {
+	i := strings.Index(s, "=")
+	if i <= 0 {
+		return fmt.Errorf("link must be Name=0xaddr, got %s", s)
+	}
+	if *l == nil {
+		*l = make(linkFlag)
+	}
+	(*l)[s[:i]] = s[i+1:]
+	return nil
+}
+
+func (l *linkFlag) String() string {
+	var links []string
+	for name, addr := range *l {
+		links = append(links, name+"="+addr)
+	}
+	sort.Strings(links)
+	return strings.Join(links, ",")
+}
+
+func (l *linkFlag) Type() string {
+	return "link"
+}
+
 func addCommonFlags(cmds []*cobra.Command) {
 	for _, c := range cmds {
-		c.Flags().Uint64VarP(&NonceFlag, "nonce", "n", 0, "nonce for transaction")
-		c.Flags().StringVarP(&AmtFlag, "amt", "a", "", "amount to send")
-		c.Flags().StringVarP(&GasFlag, "gas", "g", "", "amount of gas to provide")
-		c.Flags().StringVarP(&GasPriceFlag, "price", "p", "", "price we're willing to pay per gas")
+		c.Flags().StringVarP(&NonceFlag, "nonce", "n", "", "nonce for transaction (fetched from the node and the local nonce file if not given)")
+		c.Flags().StringVarP(&AmtFlag, "amt", "a", "", "amount to send (eg. 100, 0x64, 1.5ether, 20gwei, 1e18)")
+		c.Flags().StringVarP(&GasFlag, "gas", "g", "", "amount of gas to provide (estimated by the node if not given)")
+		c.Flags().StringVarP(&GasPriceFlag, "price", "p", "", "price we're willing to pay per gas, eg. 20gwei (fetched from the node if not given)")
+		c.Flags().Float64VarP(&GasMultiplierFlag, "gas-multiplier", "", core.GasMultiplier, "safety margin to multiply estimated gas by")
+		c.Flags().Float64VarP(&FeePercentileFlag, "fee-percentile", "", core.FeePercentile, "percentile of recent priority fees to suggest for dynamic-fee txs")
+		c.Flags().StringVarP(&MaxGasFlag, "max-gas", "", "", "abort if the gas limit would be more than this")
+		c.Flags().StringVarP(&MaxPriceFlag, "max-price", "", "", "abort if the gas price (or max fee) would be more than this")
+		c.Flags().StringVarP(&TxTypeFlag, "type", "", "legacy", "transaction type: legacy, access-list (EIP-2930) or dynamic-fee (EIP-1559)")
+		c.Flags().StringVarP(&MaxFeeFlag, "max-fee", "", "", "max total fee per gas for dynamic-fee txs (suggested from the fee history if not given)")
+		c.Flags().StringVarP(&PriorityFeeFlag, "priority-fee", "", "", "max priority fee (tip) per gas for dynamic-fee txs (suggested from the fee history if not given)")
+		c.Flags().StringVarP(&AccessListFlag, "access-list", "", "", "path to a json access list for typed txs")
+		c.Flags().StringVarP(&BundleFlag, "bundle", "", "", "add the unsigned tx to this bundle file (created if missing) for offline signing")
 	}
 }
 
@@ -97,26 +236,232 @@ func main() {
 
 	var createCmd = &cobra.Command{
 		Use:   "create",
-		Short: "ethtx create --code <code>",
-		Long:  "create a new contract",
+		Short: "ethtx create --code <code | file> [--abi <abi> args...] [--link Name=0xaddr]... [--create2 --salt <salt>]",
+		Long:  "create a new contract, from hex or a file of it (or a compiler artifact), with args for its constructor. With --create2 it's deployed through a factory, at an address that depends only on the factory, the salt and the code",
 		Run:   cliCreate,
 	}
 
 	var callCmd = &cobra.Command{
 		Use:   "call",
-		Short: "ethtx call --to <to> --data <data>",
-		Long:  "call a contract",
+		Short: "ethtx call --to <to> [--data <data> | --abi <abi> --method <method> [args...]]",
+		Long:  "call a contract, with raw --data or args encoded with a json abi",
 		Run:   cliCall,
 	}
 
+	var decodeCmd = &cobra.Command{
+		Use:   "decode",
+		Short: "ethtx decode <tx hex | ->",
+		Long:  "decode a hex encoded serialized transaction (- reads it from stdin) and recover its sender",
+		Run:   cliDecode,
+	}
+
+	var signCmd = &cobra.Command{
+		Use:   "sign",
+		Short: "ethtx sign <unsigned tx hex | bundle file | ->",
+		Long:  "sign a previously crafted unsigned transaction, or every transaction in an unsigned tx bundle",
+		Run:   cliSign,
+	}
+	signCmd.Flags().StringVarP(&OutFlag, "out", "o", "", "file to write the signed bundle to (default stdout)")
+
+	var signMessageCmd = &cobra.Command{
+		Use:   "sign-message",
+		Short: "ethtx sign-message <message | file | ->",
+		Long:  "sign a message with --addr's key, as personal_sign does (EIP-191), so it can never be a valid tx",
+		Run:   cliSignMessage,
+	}
+	signMessageCmd.Flags().BoolVarP(&HexFlag, "hex", "", false, "the message is hex encoded bytes, not text")
+
+	var signTypedDataCmd = &cobra.Command{
+		Use:   "sign-typed-data",
+		Short: "ethtx sign-typed-data <json | file | ->",
+		Long:  "sign typed structured data (EIP-712) with --addr's key, as eth_signTypedData_v4 does",
+		Run:   cliSignTypedData,
+	}
+
+	var nonceCmd = &cobra.Command{
+		Use:   "nonce",
+		Short: "manage the local nonce file of an address",
+		Long:  "inspect, reset or resync the next nonce ethtx keeps locally for an address (default --addr)",
+	}
+
+	var nonceShowCmd = &cobra.Command{
+		Use:   "show",
+		Short: "ethtx nonce show [addr]",
+		Long:  "show the local nonce of an address alongside the node's latest and pending nonces",
+		Run:   cliNonceShow,
+	}
+
+	var nonceResetCmd = &cobra.Command{
+		Use:   "reset",
+		Short: "ethtx nonce reset [addr] [nonce]",
+		Long:  "set the local nonce of an address, or forget it if no nonce is given (so the node's pending nonce is used)",
+		Run:   cliNonceReset,
+	}
+
+	var nonceResyncCmd = &cobra.Command{
+		Use:   "resync",
+		Short: "ethtx nonce resync [addr]",
+		Long:  "set the local nonce of an address to the node's pending nonce (eg. after txs were dropped)",
+		Run:   cliNonceResync,
+	}
+	nonceCmd.AddCommand(nonceShowCmd, nonceResetCmd, nonceResyncCmd)
+
+	var nameCmd = &cobra.Command{
+		Use:   "name",
+		Short: "register names with the global name registrar",
+		Long:  "reserve names in the global name registrar, point them at addresses, and transfer them",
+	}
+
+	var nameReserveCmd = &cobra.Command{
+		Use:   "reserve",
+		Short: "ethtx name reserve <name>",
+		Long:  "reserve a name for --addr, if no one owns it yet",
+		Run:   cliNameReserve,
+	}
+
+	var nameSetCmd = &cobra.Command{
+		Use:   "set",
+		Short: "ethtx name set <name> [addr]",
+		Long:  "point a name owned by --addr at an address (default --addr)",
+		Run:   cliNameSet,
+	}
+	nameSetCmd.Flags().BoolVarP(&PrimaryFlag, "primary", "", true, "make the name the address's primary name, so the address resolves back to it")
+
+	var nameOwnerCmd = &cobra.Command{
+		Use:   "owner",
+		Short: "ethtx name owner <name>",
+		Long:  "show who owns a name, and the address it points to",
+		Run:   cliNameOwner,
+	}
+
+	var nameTransferCmd = &cobra.Command{
+		Use:   "transfer",
+		Short: "ethtx name transfer <name> <new owner>",
+		Long:  "give a name owned by --addr to a new owner",
+		Run:   cliNameTransfer,
+	}
+	nameCmd.AddCommand(nameReserveCmd, nameSetCmd, nameOwnerCmd, nameTransferCmd)
+	nameCmd.PersistentFlags().StringVarP(&RegistrarFlag, "registrar", "", REGISTRAR, "address of the global name registrar contract")
+
+	var tokenCmd = &cobra.Command{
+		Use:   "token",
+		Short: "send and approve ERC-20 tokens",
+		Long:  "call a token's transfer, approve and transferFrom, with amounts in the token's decimals (eg. 1.5)",
+	}
+
+	var tokenTransferCmd = &cobra.Command{
+		Use:   "transfer",
+		Short: "ethtx token transfer <token> <to> <amount>",
+		Long:  "send tokens from --addr",
+		Run:   cliTokenTransfer,
+	}
+
+	var tokenApproveCmd = &cobra.Command{
+		Use:   "approve",
+		Short: "ethtx token approve <token> <spender> <amount | max>",
+		Long:  "let spender send up to amount of --addr's tokens",
+		Run:   cliTokenApprove,
+	}
+
+	var tokenTransferFromCmd = &cobra.Command{
+		Use:   "transferFrom",
+		Short: "ethtx token transferFrom <token> <from> <to> <amount>",
+		Long:  "send tokens that --addr was approved to spend",
+		Run:   cliTokenTransferFrom,
+	}
+	tokenCmd.AddCommand(tokenTransferCmd, tokenApproveCmd, tokenTransferFromCmd)
+
+	var nftCmd = &cobra.Command{
+		Use:   "nft",
+		Short: "send and approve ERC-721 and ERC-1155 tokens",
+		Long:  "call an NFT contract's transfers and approvals, for either standard (told apart with ERC-165)",
+	}
+
+	var nftTransferCmd = &cobra.Command{
+		Use:   "transfer",
+		Short: "ethtx nft transfer <contract> <to> <id[:amount]>...",
+		Long:  "send tokens from --from (default --addr). ERC-721 tokens go one at a time with transferFrom; ERC-1155 transfers are always safe, and batched for more than one id",
+		Run:   cliNFTTransfer,
+	}
+
+	var nftSafeTransferCmd = &cobra.Command{
+		Use:   "safeTransfer",
+		Short: "ethtx nft safeTransfer <contract> <to> <id[:amount]>...",
+		Long:  "send tokens from --from (default --addr) with safeTransferFrom, which fails if the recipient is a contract that can't take them",
+		Run:   cliNFTSafeTransfer,
+	}
+
+	var nftApproveCmd = &cobra.Command{
+		Use:   "approve",
+		Short: "ethtx nft approve <contract> <spender> <id>",
+		Long:  "let spender transfer one of --addr's ERC-721 tokens (the zero address clears the approval)",
+		Run:   cliNFTApprove,
+	}
+
+	var nftSetApprovalForAllCmd = &cobra.Command{
+		Use:   "setApprovalForAll",
+		Short: "ethtx nft setApprovalForAll <contract> <operator> [true | false]",
+		Long:  "let operator transfer all of --addr's tokens in the contract, or stop it with false",
+		Run:   cliNFTSetApprovalForAll,
+	}
+	nftCmd.AddCommand(nftTransferCmd, nftSafeTransferCmd, nftApproveCmd, nftSetApprovalForAllCmd)
+	for _, c := range []*cobra.Command{nftTransferCmd, nftSafeTransferCmd} {
+		c.Flags().StringVarP(&FromFlag, "from", "f", "", "address (or ENS name) to send the tokens from, if --addr is approved to (default --addr)")
+		c.Flags().StringVarP(&DataFlag, "data", "d", "", "hex data passed to the recipient's onERC721Received or onERC1155Received")
+	}
+
+	var accountsCmd = &cobra.Command{
+		Use:   "accounts",
+		Short: "list accounts",
+		Long:  "list the accounts of a --mnemonic-file",
+	}
+
+	var accountsDeriveCmd = &cobra.Command{
+		Use:   "derive",
+		Short: "ethtx accounts derive --mnemonic-file <file> [--hd-path <path>] [--count N]",
+		Long:  "list the addresses of the accounts derived from the mnemonic, from --hd-path on",
+		Run:   cliAccountsDerive,
+	}
+	accountsDeriveCmd.Flags().IntVarP(&CountFlag, "count", "", 1, "number of accounts to list")
+	accountsCmd.AddCommand(accountsDeriveCmd)
+
+	var speedupCmd = &cobra.Command{
+		Use:   "speedup",
+		Short: "ethtx speedup <txhash>",
+		Long:  "replace a pending tx with the same tx paying higher fees, so it's mined sooner",
+		Run:   cliSpeedup,
+	}
+
+	var cancelCmd = &cobra.Command{
+		Use:   "cancel",
+		Short: "ethtx cancel <txhash>",
+		Long:  "replace a pending tx with a zero value transfer to its sender, paying higher fees, so it's never mined",
+		Run:   cliCancel,
+	}
+	for _, c := range []*cobra.Command{speedupCmd, cancelCmd} {
+		c.Flags().StringVarP(&GasPriceFlag, "price", "p", "", "gas price for the replacement (default the original's plus --bump, or the node's suggestion if higher)")
+		c.Flags().StringVarP(&MaxFeeFlag, "max-fee", "", "", "max fee per gas for the replacement of a dynamic-fee tx (default as for --price)")
+		c.Flags().StringVarP(&PriorityFeeFlag, "priority-fee", "", "", "priority fee per gas for the replacement of a dynamic-fee tx (default as for --price)")
+		c.Flags().Int64VarP(&BumpFlag, "bump", "", core.PriceBump, "percent the replacement's fees must be above the original's (the node's replacement rule)")
+		c.Flags().StringVarP(&MaxPriceFlag, "max-price", "", "", "abort if the gas price (or max fee) would be more than this")
+	}
+
 	// custom flags
-	sendCmd.Flags().StringVarP(&ToFlag, "to", "t", "", "destination address")
-	callCmd.Flags().StringVarP(&ToFlag, "to", "t", "", "destination address")
+	sendCmd.Flags().StringVarP(&ToFlag, "to", "t", "", "destination address or ENS name")
+	callCmd.Flags().StringVarP(&ToFlag, "to", "t", "", "destination address or ENS name")
 	callCmd.Flags().StringVarP(&DataFlag, "data", "d", "", "data to send to the contract")
-	createCmd.Flags().StringVarP(&DataFlag, "code", "c", "", "code for the new contract")
+	callCmd.Flags().StringVarP(&AbiFlag, "abi", "", "", "json abi (or compiler artifact) of the contract, to encode --method and its args")
+	callCmd.Flags().StringVarP(&MethodFlag, "method", "", "", "function to call, by name or full signature if overloaded (eg. 'transfer(address,uint256)')")
+	createCmd.Flags().StringVarP(&DataFlag, "code", "c", "", "code for the new contract: hex, or a file of it or a compiler artifact with a bytecode field")
+	createCmd.Flags().StringVarP(&AbiFlag, "abi", "", "", "json abi (or compiler artifact) of the contract, to encode the constructor args (default --code, if it's an artifact)")
+	createCmd.Flags().VarP(&LinkFlags, "link", "", "library address to link into the code, as Name=0xaddr (may be repeated). __$...$__ placeholders need the fully qualified path:Name, unless the code is an artifact that lists the library")
+	createCmd.Flags().BoolVarP(&Create2Flag, "create2", "", false, "deploy with CREATE2 through --factory, at an address known in advance (see ethinfo create2-address)")
+	createCmd.Flags().StringVarP(&SaltFlag, "salt", "", "", "salt for --create2: up to 32 bytes of hex, or a number")
+	createCmd.Flags().StringVarP(&FactoryFlag, "factory", "", crypto.DeploymentProxy, "factory for --create2 that takes the salt followed by the init code (default the deterministic deployment proxy)")
 
 	// COMMANDS
-	commands := []*cobra.Command{sendCmd, createCmd, callCmd}
+	commands := []*cobra.Command{sendCmd, createCmd, callCmd, nameReserveCmd, nameSetCmd, nameTransferCmd, tokenTransferCmd, tokenApproveCmd, tokenTransferFromCmd,
+		nftTransferCmd, nftSafeTransferCmd, nftApproveCmd, nftSetApprovalForAllCmd}
 	addCommonFlags(commands)
 
 	var rootCmd = &cobra.Command{
@@ -124,29 +469,93 @@ func main() {
 		Short: "a tool for sending transactions to ethereum chains",
 		Long:  "a tool for sending transactions to ethereum chains",
 	}
+	rootCmd.PersistentFlags().StringVarP(&ProfileFlag, "profile", "", "", "profile of the config file (~/.eth-client/config.toml) to take defaults from (default $ETHTX_PROFILE, or the config's own)")
 	rootCmd.PersistentFlags().IntVarP(&LogLevelFlag, "log", "l", 0, "set the log level")
+	rootCmd.PersistentFlags().StringVarP(&OutputFlag, "output", "", "text", "output format: text, or json for one object per tx on stdout (with everything else on stderr)")
 	rootCmd.PersistentFlags().StringVarP(&SignAddrFlag, "sign-addr", "", SIGN, "address to use for signing")
+	rootCmd.PersistentFlags().StringVarP(&NonceDirFlag, "nonce-dir", "", NONCE_DIR, "directory of local nonce files, for sending multiple txs per block (empty to only use the node's pending nonce)")
+	rootCmd.PersistentFlags().StringVarP(&KeystoreFlag, "keystore", "", "", "sign with keys from this directory of v3 keystore files instead of eris-keys")
+	rootCmd.PersistentFlags().StringVarP(&PasswordFileFlag, "password-file", "", "", "file containing the passphrase for the --keystore key (prompts if not given)")
+	rootCmd.PersistentFlags().StringVarP(&MnemonicFileFlag, "mnemonic-file", "", "", "sign with a key derived from the BIP-39 mnemonic in this file (at --hd-path) instead of eris-keys")
+	rootCmd.PersistentFlags().StringVarP(&HDPathFlag, "hd-path", "", hdwallet.DefaultPath, "BIP-32 path of the --mnemonic-file key (the last index counts up for accounts derive)")
 	rootCmd.PersistentFlags().StringVarP(&HostAddrFlag, "node-addr", "", HOST, "address to use for signing")
-	rootCmd.PersistentFlags().StringVarP(&AddressFlag, "addr", "", ADDR, "address to use for signing")
+	rootCmd.PersistentFlags().StringVarP(&AddressFlag, "addr", "", ADDR, "address to use for signing (an address or ENS name)")
+	rootCmd.PersistentFlags().BoolVarP(&SkipChecksumFlag, "skip-checksum", "", false, "accept mixed case addresses with bad EIP-55 checksums")
+	rootCmd.PersistentFlags().StringVarP(&ENSRegistryFlag, "ens-registry", "", ENS_REGISTRY, "ENS registry to resolve names with (default the known one for the node's chain)")
 	rootCmd.PersistentFlags().BoolVarP(&BinaryFlag, "binary", "", false, "print the tx's rlp serialized bytes (eg. to broadcast later)")
+	rootCmd.PersistentFlags().StringVarP(&ChainIDFlag, "chain-id", "", "", "chain id for EIP-155 replay protection (fetched from the node if not given, 0 to disable)")
 	rootCmd.PersistentFlags().BoolVarP(&SignFlag, "sign", "s", false, "sign the transaction")
 	rootCmd.PersistentFlags().BoolVarP(&BroadcastFlag, "broadcast", "b", false, "broadcast the tx to the chain")
+	rootCmd.PersistentFlags().BoolVarP(&SimulateFlag, "simulate", "", true, "simulate the tx with eth_call and check the sender's balance before broadcasting it")
+	rootCmd.PersistentFlags().BoolVarP(&ForceFlag, "force", "", false, "broadcast even if the simulation fails")
 	rootCmd.PersistentFlags().BoolVarP(&WaitFlag, "wait", "w", false, "wait for the tx to be mined into a block")
+	rootCmd.PersistentFlags().DurationVarP(&TimeoutFlag, "timeout", "", core.WaitTimeout, "how long to --wait before giving up (0 waits forever)")
+	rootCmd.PersistentFlags().Uint64VarP(&ConfirmationsFlag, "confirmations", "", core.WaitConfirmations, "number of blocks (including the tx's own) to --wait for")
 
 	rootCmd.PersistentPreRun = before
 	rootCmd.PersistentPostRun = after
 
-	rootCmd.AddCommand(versionCmd)
-	rootCmd.AddCommand(commands...)
+	rootCmd.AddCommand(versionCmd, decodeCmd, signCmd, signMessageCmd, signTypedDataCmd, nonceCmd, nameCmd, tokenCmd, nftCmd, accountsCmd)
+	rootCmd.AddCommand(sendCmd, createCmd, callCmd, speedupCmd, cancelCmd)
 	rootCmd.Execute()
 }
 
 func before(cmd *cobra.Command, args []string) {
+	profile, err := utils.LoadProfile(ProfileFlag)
+	common.IfExit(err)
+	if profile != nil {
+		var skip []string
+		if cmd.Name() == "speedup" || cmd.Name() == "cancel" {
+			// replacements work their fees out from the original's
+			skip = []string{"price", "max-fee", "priority-fee"}
+		}
+		common.IfExit(profile.Apply(cmd.Flags(), skip...))
+	}
+
 	SignAddrFlag = "http://" + SignAddrFlag
 	HostAddrFlag = "http://" + HostAddrFlag
 	core.EthClient = utils.NewClient(HostAddrFlag)
+	switch {
+	case MnemonicFileFlag != "" && KeystoreFlag != "":
+		common.Exit(fmt.Errorf("--mnemonic-file and --keystore can't be used together"))
+	case MnemonicFileFlag != "":
+		wallet, path := loadWallet()
+		account, err := wallet.Derive(path)
+		common.IfExit(err)
+		signer = core.NewHDSigner(account)
+		// sign as the derived account unless told otherwise
+		if AddressFlag == "" {
+			AddressFlag = utils.ChecksumAddress(account.Address.Bytes())
+		}
+	case KeystoreFlag != "":
+		signer = core.NewKeystoreSigner(KeystoreFlag, keystorePassphrase)
+	default:
+		signer = core.NewKeysSigner(SignAddrFlag)
+	}
+	// only reserve nonces for txs that will be used
+	if NonceDirFlag != "" && (SignFlag || BinaryFlag || BundleFlag != "") {
+		core.Nonces = core.NewNonceManager(NonceDirFlag)
+	}
+	core.GasMultiplier = GasMultiplierFlag
+	core.FeePercentile = FeePercentileFlag
+	core.WaitTimeout = TimeoutFlag
+	core.WaitConfirmations = ConfirmationsFlag
+
+	logOut := os.Stdout
+	switch OutputFlag {
+	case "text":
+	case "json":
+		// keep stdout for the json, so anything else printed (including errors) goes to stderr
+		jsonOut, logOut = os.Stdout, os.Stderr
+	default:
+		common.Exit(fmt.Errorf("unknown --output %s (must be text or json)", OutputFlag))
+	}
+	log.SetLoggers(log.LogLevel(LogLevelFlag), logOut, os.Stderr)
 
-	log.SetLoggers(log.LogLevel(LogLevelFlag), os.Stdout, os.Stderr)
+	utils.SkipChecksum = SkipChecksumFlag
+	AddressFlag = resolveAddr(AddressFlag)
+	ToFlag = resolveAddr(ToFlag)
+	abi.ResolveAddress = resolveArg
 }
 
 func after(cmd *cobra.Command, args []string) {
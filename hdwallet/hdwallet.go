@@ -0,0 +1,206 @@
+package hdwallet
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/crypto"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/ethereum/go-ethereum/common"
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/tyler-smith/go-bip39"
+)
+
+//---------------------------------------------------------------
+// hierarchical deterministic wallets
+//
+// A mnemonic (BIP-39) is turned into a seed, from which a tree of keys is
+// derived (BIP-32). Each key is at a path of child indexes from the master
+// key, and ethereum accounts are at m/44'/60'/0'/0/N (BIP-44), where the '
+// marks a hardened index, whose children can't be derived from its public key.
+
+// The path of the first ethereum account, as used by geth, metamask and hardhat
+const DefaultPath = "m/44'/60'/0'/0/0"
+
+// Indexes from here on are hardened
+const HardenedOffset = 0x80000000
+
+// A path of child indexes from the master key
+type Path []uint32
+
+// Parse a path like m/44'/60'/0'/0/0, where hardened indexes end in ' or h
+func ParsePath(s string) (Path, error) {
+	parts := strings.Split(strings.TrimSpace(s), "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("bad hd path %s (must start with m/)", s)
+	}
+	path := make(Path, len(parts)-1)
+	for i, part := range parts[1:] {
+		var offset uint32
+		if strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") {
+			part, offset = part[:len(part)-1], HardenedOffset
+		}
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || index >= HardenedOffset {
+			return nil, fmt.Errorf("bad index %s in hd path %s", parts[i+1], s)
+		}
+		path[i] = uint32(index) + offset
+	}
+	return path, nil
+}
+
+func (p Path) String() string {
+	s := "m"
+	for _, index := range p {
+		if index >= HardenedOffset {
+			s += fmt.Sprintf("/%d'", index-HardenedOffset)
+		} else {
+			s += fmt.Sprintf("/%d", index)
+		}
+	}
+	return s
+}
+
+// The path n accounts on: with its last index moved on by n
+func (p Path) Next(n uint32) (Path, error) {
+	if len(p) == 0 {
+		return nil, fmt.Errorf("the master key's path has no index to count from")
+	}
+	last := p[len(p)-1]
+	if next := last + n; next < last || (last < HardenedOffset && next >= HardenedOffset) {
+		return nil, fmt.Errorf("%s has no index %d on", p, n)
+	}
+	next := append(Path{}, p...)
+	next[len(next)-1] += n
+	return next, nil
+}
+
+//---------------------------------------------------------------
+// mnemonics
+
+// Load a mnemonic from the first line of a file
+func LoadMnemonic(path string) (string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	mnemonic := strings.SplitN(string(b), "\n", 2)[0]
+	return strings.Join(strings.Fields(mnemonic), " "), nil
+}
+
+//---------------------------------------------------------------
+// derivation
+
+type Wallet struct {
+	seed []byte
+}
+
+// A wallet from a mnemonic, with its checksum checked.
+// The passphrase (often empty) is mixed into the seed
+func New(mnemonic, passphrase string) (*Wallet, error) {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, fmt.Errorf("bad mnemonic (it must be 12 to 24 words of the BIP-39 english list, with a valid checksum)")
+	}
+	return NewFromSeed(bip39.NewSeed(mnemonic, passphrase)), nil
+}
+
+func NewFromSeed(seed []byte) *Wallet {
+	return &Wallet{seed}
+}
+
+type Account struct {
+	Path       Path
+	Address    common.Address
+	PrivateKey *secp256k1.PrivateKey
+}
+
+// The account at the path
+func (w *Wallet) Derive(path Path) (*Account, error) {
+	k, err := newMaster(w.seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range path {
+		if k, err = k.child(index); err != nil {
+			return nil, fmt.Errorf("can't derive %s: %v", path, err)
+		}
+	}
+	priv := secp256k1.NewPrivateKey(&k.key)
+	return &Account{path, crypto.PrivkeyToAddress(priv), priv}, nil
+}
+
+// count accounts, from the one at the path on
+func (w *Wallet) Accounts(path Path, count int) ([]*Account, error) {
+	accounts := make([]*Account, count)
+	for i := range accounts {
+		p, err := path.Next(uint32(i))
+		if err != nil {
+			return nil, err
+		}
+		if accounts[i], err = w.Derive(p); err != nil {
+			return nil, err
+		}
+	}
+	return accounts, nil
+}
+
+// a private key with the chain code its children are derived with
+type extendedKey struct {
+	key       secp256k1.ModNScalar
+	chainCode []byte
+}
+
+func newMaster(seed []byte) (*extendedKey, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, fmt.Errorf("seed must be 16 to 64 bytes, got %d", len(seed))
+	}
+	k, err := fromHMAC([]byte("Bitcoin seed"), seed)
+	if err != nil {
+		return nil, err
+	}
+	if k.key.IsZero() {
+		return nil, fmt.Errorf("seed gives an invalid master key")
+	}
+	return k, nil
+}
+
+// the index'th child: keys are derived from the parent's private key if the
+// index is hardened, and its public key otherwise
+func (k *extendedKey) child(index uint32) (*extendedKey, error) {
+	var data []byte
+	if index >= HardenedOffset {
+		key := k.key.Bytes()
+		data = append([]byte{0}, key[:]...)
+	} else {
+		data = secp256k1.NewPrivateKey(&k.key).PubKey().SerializeCompressed()
+	}
+	var i [4]byte
+	binary.BigEndian.PutUint32(i[:], index)
+	child, err := fromHMAC(k.chainCode, append(data, i[:]...))
+	if err != nil {
+		return nil, err
+	}
+	child.key.Add(&k.key)
+	if child.key.IsZero() {
+		return nil, fmt.Errorf("index %d gives an invalid key", index)
+	}
+	return child, nil
+}
+
+// the left half of HMAC-SHA512 is the key, the right the chain code
+func fromHMAC(key, data []byte) (*extendedKey, error) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	k := new(extendedKey)
+	if overflow := k.key.SetByteSlice(sum[:32]); overflow {
+		return nil, fmt.Errorf("key is not less than the curve order")
+	}
+	k.chainCode = sum[32:]
+	return k, nil
+}
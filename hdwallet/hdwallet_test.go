@@ -0,0 +1,107 @@
+package hdwallet
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// the private keys of BIP-32's test vectors 1 and 2
+var bip32Tests = []struct {
+	seed, path, key string
+}{
+	{"000102030405060708090a0b0c0d0e0f", "m", "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+	{"000102030405060708090a0b0c0d0e0f", "m/0'", "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+	{"000102030405060708090a0b0c0d0e0f", "m/0'/1/2'/2/1000000000", "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+	{"fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542", "m/0", "abe74a98f6c7eabee0428f53798f0ab8aa1bd37873999041703c742f15ac7e1e"},
+}
+
+func TestBIP32(t *testing.T) {
+	for _, test := range bip32Tests {
+		seed, _ := hex.DecodeString(test.seed)
+		path, err := ParsePath(test.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		account, err := NewFromSeed(seed).Derive(path)
+		if err != nil {
+			t.Errorf("%s: %v", test.path, err)
+			continue
+		}
+		if got := hex.EncodeToString(account.PrivateKey.Serialize()); got != test.key {
+			t.Errorf("%s: key is %s, want %s", test.path, got, test.key)
+		}
+	}
+}
+
+// the ethereum accounts of well known mnemonics, as derived by metamask and hardhat
+var mnemonicTests = []struct {
+	mnemonic string
+	addrs    []string
+}{
+	{strings.Repeat("abandon ", 11) + "about", []string{"9858effd232b4033e47d90003d41ec34ecaeda94"}},
+	{"test test test test test test test test test test test junk", []string{
+		"f39fd6e51aad88f6f4ce6ab8827279cfffb92266",
+		"70997970c51812dc3a010c7d01b50e0d17dc79c8",
+		"3c44cdddb6a900fa2b585dd299e03d12fa4293bc",
+	}},
+}
+
+func TestMnemonicAccounts(t *testing.T) {
+	path, err := ParsePath(DefaultPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range mnemonicTests {
+		wallet, err := New(test.mnemonic, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		accounts, err := wallet.Accounts(path, len(test.addrs))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, account := range accounts {
+			if got := hex.EncodeToString(account.Address.Bytes()); got != test.addrs[i] {
+				t.Errorf("%s account %d is %s, want %s", test.mnemonic, i, got, test.addrs[i])
+			}
+		}
+	}
+
+	// a bad checksum
+	if _, err := New(strings.Repeat("abandon ", 12), ""); err == nil {
+		t.Error("accepted a mnemonic with a bad checksum")
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		path Path
+	}{
+		{"m", Path{}},
+		{"m/44'/60'/0'/0/0", Path{44 + HardenedOffset, 60 + HardenedOffset, HardenedOffset, 0, 0}},
+		{"m/44h/60h/0h/0/7", Path{44 + HardenedOffset, 60 + HardenedOffset, HardenedOffset, 0, 7}},
+	} {
+		path, err := ParsePath(test.s)
+		if err != nil {
+			t.Errorf("%s: %v", test.s, err)
+			continue
+		}
+		if path.String() != strings.Replace(test.s, "h", "'", -1) || len(path) != len(test.path) {
+			t.Errorf("%s parsed as %s", test.s, path)
+			continue
+		}
+		for i := range path {
+			if path[i] != test.path[i] {
+				t.Errorf("%s parsed as %s", test.s, path)
+				break
+			}
+		}
+	}
+	for _, s := range []string{"", "44'/60'", "m/x", "m/2147483648", "m//0"} {
+		if path, err := ParsePath(s); err == nil {
+			t.Errorf("%s parsed as %s", s, path)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package namereg
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/abi"
+	"github.com/eris-ltd/eth-client/crypto"
+	"github.com/eris-ltd/eth-client/utils"
+)
+
+//---------------------------------------------------------------
+// the global name registrar
+//
+// go-ethereum's GlobalRegistrar contract (see Godeps/.../common/registrar)
+// maps bytes32 names to records of an owner and an address. Names are
+// reserved first-come first-served, and only a record's owner can point
+// it at an address or transfer it.
+//
+// The vendored registrar package needs a go-ethereum backend and the
+// unvendored crypto package, so the calls and storage layout are redone here.
+
+// The registrar's storage: mapping(address => bytes32) m_toName at slot 0,
+// mapping(bytes32 => Record) m_toRecord at slot 1
+const (
+	toNameSlot   = 0
+	toRecordSlot = 1
+
+	// fields of a Record
+	OwnerField   = 0
+	AddressField = 1 // the primary address
+)
+
+var (
+	name32  = abi.Argument{Name: "_name", Type: abi.Type{Kind: abi.FixedBytesKind, Size: 32}}
+	address = abi.Type{Kind: abi.AddressKind, Size: 20}
+
+	reserveMethod    = &abi.Method{Name: "reserve", Inputs: []abi.Argument{name32}}
+	setAddressMethod = &abi.Method{Name: "setAddress", Inputs: []abi.Argument{name32, {Name: "_a", Type: address}, {Name: "_primary", Type: abi.Type{Kind: abi.BoolKind}}}}
+	transferMethod   = &abi.Method{Name: "transfer", Inputs: []abi.Argument{name32, {Name: "_newOwner", Type: address}}}
+)
+
+// A name as the registrar's bytes32 key: utf8, right padded with zeros
+func EncodeName(name string) ([]byte, error) {
+	if name == "" {
+		return nil, fmt.Errorf("name must not be empty")
+	}
+	if len(name) > 32 {
+		return nil, fmt.Errorf("name %s is %d bytes, the registrar takes at most 32", name, len(name))
+	}
+	b := make([]byte, 32)
+	copy(b, name)
+	return b, nil
+}
+
+// The call data to reserve a name for the sender
+func ReserveData(name string) ([]byte, error) {
+	n, err := EncodeName(name)
+	if err != nil {
+		return nil, err
+	}
+	return reserveMethod.Pack([]string{utils.HexString(n)})
+}
+
+// The call data to point a name at an address. A primary address
+// also resolves back to the name
+func SetAddressData(name string, addr []byte, primary bool) ([]byte, error) {
+	n, err := EncodeName(name)
+	if err != nil {
+		return nil, err
+	}
+	return setAddressMethod.Pack([]string{utils.HexString(n), utils.HexString(addr), fmt.Sprint(primary)})
+}
+
+// The call data to give a name to a new owner
+func TransferData(name string, newOwner []byte) ([]byte, error) {
+	n, err := EncodeName(name)
+	if err != nil {
+		return nil, err
+	}
+	return transferMethod.Pack([]string{utils.HexString(n), utils.HexString(newOwner)})
+}
+
+// The storage key of a field of a name's record
+func RecordKey(name string, field int) ([]byte, error) {
+	n, err := EncodeName(name)
+	if err != nil {
+		return nil, err
+	}
+	slot := new(big.Int).SetBytes(mappingKey(n, toRecordSlot))
+	return leftPad(slot.Add(slot, big.NewInt(int64(field))).Bytes()), nil
+}
+
+// The storage key of the name an address is the primary address of
+func NameKey(addr []byte) []byte {
+	return mappingKey(leftPad(addr), toNameSlot)
+}
+
+// solidity keeps mapping[key] at keccak256(key . slot)
+func mappingKey(key []byte, slot int64) []byte {
+	return crypto.Keccak256(key, leftPad(big.NewInt(slot).Bytes()))
+}
+
+//---------------------------------------------------------------
+// lookups, straight from the registrar's storage
+
+// The owner of a name (nil if it isn't reserved)
+func Owner(client *utils.Client, registrar, name string) ([]byte, error) {
+	return lookupAddress(client, registrar, name, OwnerField)
+}
+
+// The address a name points to (nil if it's unset)
+func Resolve(client *utils.Client, registrar, name string) ([]byte, error) {
+	return lookupAddress(client, registrar, name, AddressField)
+}
+
+// The name an address is the primary address of ("" if none)
+func ReverseResolve(client *utils.Client, registrar string, addr []byte) (string, error) {
+	word, err := storageAt(client, registrar, NameKey(addr))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(word), "\x00"), nil
+}
+
+func lookupAddress(client *utils.Client, registrar, name string, field int) ([]byte, error) {
+	key, err := RecordKey(name, field)
+	if err != nil {
+		return nil, err
+	}
+	word, err := storageAt(client, registrar, key)
+	if err != nil {
+		return nil, err
+	}
+	addr := word[12:]
+	if new(big.Int).SetBytes(addr).Sign() == 0 {
+		return nil, nil
+	}
+	return addr, nil
+}
+
+// read a 32 byte storage word of the registrar
+func storageAt(client *utils.Client, registrar string, key []byte) ([]byte, error) {
+	if registrar == "" {
+		return nil, fmt.Errorf("the registrar's address must be given with --registrar")
+	}
+	if _, err := utils.ParseAddress(registrar); err != nil {
+		return nil, fmt.Errorf("bad --registrar: %v", err)
+	}
+	r, err := client.RequestResponse("eth", "getStorageAt", registrar, utils.HexString(key), "latest")
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the registrar's storage: %v", err)
+	}
+	s, ok := r.(string)
+	if !ok {
+		return nil, fmt.Errorf("node returned bad storage %v", r)
+	}
+	// some nodes drop leading zeros, eg. 0x0
+	s = utils.StripHex(s)
+	if s == "" {
+		s = "0"
+	}
+	x, ok := new(big.Int).SetString(s, 16)
+	if !ok || x.BitLen() > 256 {
+		return nil, fmt.Errorf("node returned bad storage 0x%s", s)
+	}
+	return leftPad(x.Bytes()), nil
+}
+
+func leftPad(b []byte) []byte {
+	return append(make([]byte, 32-len(b)), b...)
+}
@@ -0,0 +1,54 @@
+package namereg
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// the keys of the registrar's storage, for a known name and address
+func TestStorageKeys(t *testing.T) {
+	for _, test := range []struct {
+		field int
+		key   string
+	}{
+		// keccak256("mydapp" right padded . uint256(1)): m_toRecord["mydapp"]
+		{OwnerField, "afab979d0c8bd4080d3a81be4cca0c68955b5cac89f778fbf4dde7ed229bd918"},
+		{AddressField, "afab979d0c8bd4080d3a81be4cca0c68955b5cac89f778fbf4dde7ed229bd919"},
+	} {
+		key, err := RecordKey("mydapp", test.field)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := hex.EncodeToString(key); got != test.key {
+			t.Errorf("field %d of mydapp: got key %s, want %s", test.field, got, test.key)
+		}
+	}
+
+	// keccak256(address left padded . uint256(0)): m_toName[address]
+	addr, _ := hex.DecodeString("5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	want := "8c91756929b31621788f506e8cf1c34326cd9cdef5119070f07e9d944585d0a1"
+	if got := hex.EncodeToString(NameKey(addr)); got != want {
+		t.Errorf("name of 0x%x: got key %s, want %s", addr, got, want)
+	}
+}
+
+func TestEncodeName(t *testing.T) {
+	for _, test := range []struct {
+		name, encoded string // encoded "" means an error
+	}{
+		{"mydapp", "6d7964617070" + strings.Repeat("0", 52)},
+		{strings.Repeat("a", 32), strings.Repeat("61", 32)},
+		{strings.Repeat("a", 33), ""},
+		{"", ""},
+	} {
+		b, err := EncodeName(test.name)
+		if test.encoded == "" {
+			if err == nil {
+				t.Errorf("%q encoded as %x", test.name, b)
+			}
+		} else if got := hex.EncodeToString(b); err != nil || got != test.encoded {
+			t.Errorf("%q encoded as %s (%v), want %s", test.name, got, err, test.encoded)
+		}
+	}
+}
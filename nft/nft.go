@@ -0,0 +1,214 @@
+package nft
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/abi"
+	"github.com/eris-ltd/eth-client/utils"
+)
+
+//---------------------------------------------------------------
+// ERC-721 and ERC-1155 tokens
+//
+// ERC-721 tokens each have a single owner. ERC-1155 contracts hold many
+// tokens, each with balances, so they can be fungible or not, and only
+// have safe transfers (which call the recipient's onERC1155Received hook).
+// Contracts of both say which they are through ERC-165's supportsInterface.
+
+type Standard int
+
+const (
+	ERC721 Standard = iota
+	ERC1155
+)
+
+func (s Standard) String() string {
+	if s == ERC1155 {
+		return "ERC-1155"
+	}
+	return "ERC-721"
+}
+
+// ERC-165 interface ids: the xor of the interface's selectors
+var (
+	erc165ID  = []byte{0x01, 0xff, 0xc9, 0xa7}
+	invalidID = []byte{0xff, 0xff, 0xff, 0xff} // must not be supported
+	erc721ID  = []byte{0x80, 0xac, 0x58, 0xcd}
+	erc1155ID = []byte{0xd9, 0xb6, 0x7a, 0x26}
+)
+
+var (
+	address  = abi.Type{Kind: abi.AddressKind, Size: 20}
+	uint256  = abi.Type{Kind: abi.UintKind, Size: 256}
+	uints    = abi.Type{Kind: abi.SliceKind, Elem: &uint256}
+	boolType = abi.Type{Kind: abi.BoolKind}
+	bytesArg = abi.Argument{Name: "_data", Type: abi.Type{Kind: abi.BytesKind}}
+
+	supportsInterfaceMethod = &abi.Method{Name: "supportsInterface", Inputs: []abi.Argument{{Name: "interfaceID", Type: abi.Type{Kind: abi.FixedBytesKind, Size: 4}}}, Outputs: []abi.Argument{{Type: boolType}}}
+	setApprovalForAllMethod = &abi.Method{Name: "setApprovalForAll", Inputs: []abi.Argument{{Name: "_operator", Type: address}, {Name: "_approved", Type: boolType}}}
+
+	// ERC-721
+	balanceOfMethod        = &abi.Method{Name: "balanceOf", Inputs: []abi.Argument{{Name: "_owner", Type: address}}, Outputs: []abi.Argument{{Type: uint256}}}
+	ownerOfMethod          = &abi.Method{Name: "ownerOf", Inputs: []abi.Argument{{Name: "_tokenId", Type: uint256}}, Outputs: []abi.Argument{{Type: address}}}
+	tokenURIMethod         = &abi.Method{Name: "tokenURI", Inputs: []abi.Argument{{Name: "_tokenId", Type: uint256}}, Outputs: []abi.Argument{{Type: abi.Type{Kind: abi.StringKind}}}}
+	transferFromMethod     = &abi.Method{Name: "transferFrom", Inputs: []abi.Argument{{Name: "_from", Type: address}, {Name: "_to", Type: address}, {Name: "_tokenId", Type: uint256}}}
+	safeTransferFromMethod = &abi.Method{Name: "safeTransferFrom", Inputs: []abi.Argument{{Name: "_from", Type: address}, {Name: "_to", Type: address}, {Name: "_tokenId", Type: uint256}, bytesArg}}
+	approveMethod          = &abi.Method{Name: "approve", Inputs: []abi.Argument{{Name: "_approved", Type: address}, {Name: "_tokenId", Type: uint256}}}
+
+	// ERC-1155
+	balanceOf1155Method         = &abi.Method{Name: "balanceOf", Inputs: []abi.Argument{{Name: "_owner", Type: address}, {Name: "_id", Type: uint256}}, Outputs: []abi.Argument{{Type: uint256}}}
+	uriMethod                   = &abi.Method{Name: "uri", Inputs: []abi.Argument{{Name: "_id", Type: uint256}}, Outputs: []abi.Argument{{Type: abi.Type{Kind: abi.StringKind}}}}
+	safeTransferFrom1155Method  = &abi.Method{Name: "safeTransferFrom", Inputs: []abi.Argument{{Name: "_from", Type: address}, {Name: "_to", Type: address}, {Name: "_id", Type: uint256}, {Name: "_value", Type: uint256}, bytesArg}}
+	safeBatchTransferFromMethod = &abi.Method{Name: "safeBatchTransferFrom", Inputs: []abi.Argument{{Name: "_from", Type: address}, {Name: "_to", Type: address}, {Name: "_ids", Type: uints}, {Name: "_values", Type: uints}, bytesArg}}
+)
+
+// Find out whether the contract is an ERC-721 or ERC-1155 one, through ERC-165
+func Detect(client *utils.Client, contract []byte) (Standard, error) {
+	if !supportsInterface(client, contract, erc165ID) || supportsInterface(client, contract, invalidID) {
+		return 0, fmt.Errorf("%s doesn't implement ERC-165, so it can't be told apart as ERC-721 or ERC-1155", utils.ChecksumAddress(contract))
+	}
+	if supportsInterface(client, contract, erc721ID) {
+		return ERC721, nil
+	}
+	if supportsInterface(client, contract, erc1155ID) {
+		return ERC1155, nil
+	}
+	return 0, fmt.Errorf("%s supports neither ERC-721 nor ERC-1155", utils.ChecksumAddress(contract))
+}
+
+// a contract without supportsInterface (that reverts, or returns nothing) supports nothing
+func supportsInterface(client *utils.Client, contract, id []byte) bool {
+	values, err := abi.CallMethod(client, contract, supportsInterfaceMethod, utils.HexString(id))
+	if err != nil {
+		return false
+	}
+	return values[0].Value == true
+}
+
+// A token id, in decimal or hex
+func ParseID(s string) (*big.Int, error) {
+	id := new(big.Int)
+	var ok bool
+	if strings.HasPrefix(s, "0x") {
+		_, ok = id.SetString(s[2:], 16)
+	} else {
+		_, ok = id.SetString(s, 10)
+	}
+	if !ok || id.Sign() < 0 || id.BitLen() > 256 {
+		return nil, fmt.Errorf("bad token id %s", s)
+	}
+	return id, nil
+}
+
+//---------------------------------------------------------------
+// call data
+
+// The call data to send tokens from one address to another.
+// ERC-721 transfers one token, with transferFrom unless safe,
+// which has the contract check the recipient can take it.
+// ERC-1155 transfers are always safe, and batched if there's more than one id.
+// data is passed to the recipient's hook by safe transfers
+func TransferData(std Standard, from, to []byte, ids, amounts []*big.Int, safe bool, data []byte) ([]byte, error) {
+	if len(ids) == 0 || len(ids) != len(amounts) {
+		return nil, fmt.Errorf("need an amount for each of the token ids")
+	}
+	if std == ERC721 {
+		if len(ids) != 1 {
+			return nil, fmt.Errorf("ERC-721 tokens are transferred one at a time")
+		}
+		if amounts[0].Cmp(big.NewInt(1)) != 0 {
+			return nil, fmt.Errorf("ERC-721 tokens are unique, so the amount can only be 1")
+		}
+		if !safe {
+			if len(data) > 0 {
+				return nil, fmt.Errorf("data is only passed on by safe transfers")
+			}
+			return transferFromMethod.Pack([]string{utils.HexString(from), utils.HexString(to), ids[0].String()})
+		}
+		return safeTransferFromMethod.Pack([]string{utils.HexString(from), utils.HexString(to), ids[0].String(), utils.HexString(data)})
+	}
+
+	if len(ids) == 1 {
+		return safeTransferFrom1155Method.Pack([]string{utils.HexString(from), utils.HexString(to), ids[0].String(), amounts[0].String(), utils.HexString(data)})
+	}
+	return safeBatchTransferFromMethod.Pack([]string{utils.HexString(from), utils.HexString(to), jsonList(ids), jsonList(amounts), utils.HexString(data)})
+}
+
+// The call data to let a spender transfer one ERC-721 token (or nobody, with the zero address)
+func ApproveData(std Standard, spender []byte, id *big.Int) ([]byte, error) {
+	if std != ERC721 {
+		return nil, fmt.Errorf("%s has no approvals for single tokens (use setApprovalForAll)", std)
+	}
+	return approveMethod.Pack([]string{utils.HexString(spender), id.String()})
+}
+
+// The call data to let an operator transfer all the sender's tokens, or to stop it
+func SetApprovalForAllData(operator []byte, approved bool) ([]byte, error) {
+	return setApprovalForAllMethod.Pack([]string{utils.HexString(operator), fmt.Sprint(approved)})
+}
+
+// a uint256[] arg, as json
+func jsonList(xs []*big.Int) string {
+	s := make([]string, len(xs))
+	for i, x := range xs {
+		s[i] = `"` + x.String() + `"`
+	}
+	return "[" + strings.Join(s, ",") + "]"
+}
+
+//---------------------------------------------------------------
+// lookups
+
+// The owner of an ERC-721 token
+func OwnerOf(client *utils.Client, contract []byte, std Standard, id *big.Int) (string, error) {
+	if std != ERC721 {
+		return "", fmt.Errorf("%s tokens have balances, not a single owner (use balance)", std)
+	}
+	values, err := abi.CallMethod(client, contract, ownerOfMethod, id.String())
+	if err != nil {
+		return "", err
+	}
+	return values[0].Value.(string), nil
+}
+
+// The token's metadata URI. ERC-1155 URIs are templates, with
+// {id} standing for the id in hex, which is filled in
+func TokenURI(client *utils.Client, contract []byte, std Standard, id *big.Int) (string, error) {
+	if std == ERC721 {
+		values, err := abi.CallMethod(client, contract, tokenURIMethod, id.String())
+		if err != nil {
+			return "", err
+		}
+		return values[0].Value.(string), nil
+	}
+	values, err := abi.CallMethod(client, contract, uriMethod, id.String())
+	if err != nil {
+		return "", err
+	}
+	return strings.Replace(values[0].Value.(string), "{id}", fmt.Sprintf("%064x", id), -1), nil
+}
+
+// The number of the contract's tokens an address owns (ERC-721),
+// or how many of the token with the id it has (ERC-1155)
+func BalanceOf(client *utils.Client, contract []byte, std Standard, owner []byte, id *big.Int) (*big.Int, error) {
+	var values []abi.Value
+	var err error
+	if std == ERC721 {
+		if id != nil {
+			return nil, fmt.Errorf("ERC-721 balances are of the whole contract, not of a token id (use owner)")
+		}
+		values, err = abi.CallMethod(client, contract, balanceOfMethod, utils.HexString(owner))
+	} else {
+		if id == nil {
+			return nil, fmt.Errorf("ERC-1155 balances are of a token id, so one must be given")
+		}
+		values, err = abi.CallMethod(client, contract, balanceOf1155Method, utils.HexString(owner), id.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+	x, _ := new(big.Int).SetString(values[0].Value.(string), 10)
+	return x, nil
+}
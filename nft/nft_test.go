@@ -0,0 +1,65 @@
+package nft
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eris-ltd/eth-client/utils"
+)
+
+func TestDetect(t *testing.T) {
+	// a contract that supports the interface ids in supported, or
+	// has no supportsInterface (returns nothing) if supported is nil
+	var supported []string
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Params []json.RawMessage
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		var call struct{ Data string }
+		json.Unmarshal(req.Params[0], &call)
+		if !strings.HasPrefix(call.Data, "0x01ffc9a7") || supported == nil {
+			fmt.Fprint(w, `{"jsonrpc": "2.0", "id": "", "result": "0x"}`)
+			return
+		}
+		ok := 0
+		for _, id := range supported {
+			if call.Data[10:18] == id {
+				ok = 1
+			}
+		}
+		fmt.Fprintf(w, `{"jsonrpc": "2.0", "id": "", "result": "0x%064x"}`, ok)
+	}))
+	defer node.Close()
+	client := utils.NewClient(node.URL)
+	contract, _ := hex.DecodeString("0000000000000000000000000000000000000721")
+
+	for _, test := range []struct {
+		name      string
+		supported []string
+		std       Standard
+		ok        bool
+	}{
+		{"erc-721", []string{"01ffc9a7", "80ac58cd"}, ERC721, true},
+		{"erc-1155", []string{"01ffc9a7", "d9b67a26"}, ERC1155, true},
+		{"no supportsInterface", nil, 0, false},
+		{"supports 0xffffffff", []string{"01ffc9a7", "ffffffff", "80ac58cd"}, 0, false},
+		{"erc-721 without erc-165", []string{"80ac58cd"}, 0, false},
+		{"neither", []string{"01ffc9a7"}, 0, false},
+	} {
+		supported = test.supported
+		std, err := Detect(client, contract)
+		if !test.ok {
+			if err == nil {
+				t.Errorf("%s: detected %s", test.name, std)
+			}
+		} else if err != nil || std != test.std {
+			t.Errorf("%s: detected %s (%v), want %s", test.name, std, err, test.std)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package token
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/abi"
+	"github.com/eris-ltd/eth-client/utils"
+)
+
+//---------------------------------------------------------------
+// ERC-20 tokens
+//
+// The standard's functions are built in, so token calls don't need an abi.
+// Some tokens predate the standard settling: their transfer functions
+// return nothing rather than a bool (eg. USDT), and their name and
+// symbol are bytes32 rather than strings (eg. MKR).
+
+var (
+	address = abi.Type{Kind: abi.AddressKind, Size: 20}
+	uint256 = abi.Type{Kind: abi.UintKind, Size: 256}
+	success = []abi.Argument{{Name: "success", Type: abi.Type{Kind: abi.BoolKind}}}
+
+	nameMethod         = &abi.Method{Name: "name", Outputs: []abi.Argument{{Type: abi.Type{Kind: abi.StringKind}}}}
+	symbolMethod       = &abi.Method{Name: "symbol", Outputs: []abi.Argument{{Type: abi.Type{Kind: abi.StringKind}}}}
+	decimalsMethod     = &abi.Method{Name: "decimals", Outputs: []abi.Argument{{Type: abi.Type{Kind: abi.UintKind, Size: 8}}}}
+	totalSupplyMethod  = &abi.Method{Name: "totalSupply", Outputs: []abi.Argument{{Type: uint256}}}
+	balanceOfMethod    = &abi.Method{Name: "balanceOf", Inputs: []abi.Argument{{Name: "_owner", Type: address}}, Outputs: []abi.Argument{{Type: uint256}}}
+	allowanceMethod    = &abi.Method{Name: "allowance", Inputs: []abi.Argument{{Name: "_owner", Type: address}, {Name: "_spender", Type: address}}, Outputs: []abi.Argument{{Type: uint256}}}
+	transferMethod     = &abi.Method{Name: "transfer", Inputs: []abi.Argument{{Name: "_to", Type: address}, {Name: "_value", Type: uint256}}, Outputs: success}
+	approveMethod      = &abi.Method{Name: "approve", Inputs: []abi.Argument{{Name: "_spender", Type: address}, {Name: "_value", Type: uint256}}, Outputs: success}
+	transferFromMethod = &abi.Method{Name: "transferFrom", Inputs: []abi.Argument{{Name: "_from", Type: address}, {Name: "_to", Type: address}, {Name: "_value", Type: uint256}}, Outputs: success}
+)
+
+// The largest amount, for unlimited approvals
+var MaxAmount = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// The call data to send tokens from the sender
+func TransferData(to []byte, amount *big.Int) ([]byte, error) {
+	return transferMethod.Pack([]string{utils.HexString(to), amount.String()})
+}
+
+// The call data to let a spender send up to amount of the sender's tokens
+func ApproveData(spender []byte, amount *big.Int) ([]byte, error) {
+	return approveMethod.Pack([]string{utils.HexString(spender), amount.String()})
+}
+
+// The call data for a spender to send tokens it was approved for
+func TransferFromData(from, to []byte, amount *big.Int) ([]byte, error) {
+	return transferFromMethod.Pack([]string{utils.HexString(from), utils.HexString(to), amount.String()})
+}
+
+// Check what a transfer, approve or transferFrom returned: true,
+// or nothing from tokens that don't return a bool. Tokens that
+// return false haven't done anything, but the tx still succeeds
+func CheckReturn(ret []byte) error {
+	if len(ret) == 0 {
+		return nil
+	}
+	values, err := abi.UnpackArgs(success, ret)
+	if err != nil {
+		return fmt.Errorf("token returned 0x%x, which isn't a bool", ret)
+	}
+	if values[0].Value != true {
+		return fmt.Errorf("token returned false (the tx would succeed without transferring anything)")
+	}
+	return nil
+}
+
+//---------------------------------------------------------------
+// lookups
+
+type Info struct {
+	Name        string // "" if the token has none
+	Symbol      string
+	Decimals    int // -1 if the token has none
+	TotalSupply *big.Int
+}
+
+// The token's name, symbol, decimals and total supply.
+// Only the total supply is required by the standard
+func GetInfo(client *utils.Client, token []byte) (*Info, error) {
+	info := &Info{Decimals: -1}
+	var err error
+	if info.TotalSupply, err = uintCall(client, token, totalSupplyMethod); err != nil {
+		return nil, err
+	}
+	info.Name, _ = stringCall(client, token, nameMethod)
+	info.Symbol, _ = stringCall(client, token, symbolMethod)
+	if decimals, err := Decimals(client, token); err == nil {
+		info.Decimals = decimals
+	}
+	return info, nil
+}
+
+// The number of decimals token amounts are shown with
+func Decimals(client *utils.Client, token []byte) (int, error) {
+	x, err := uintCall(client, token, decimalsMethod)
+	if err != nil {
+		return 0, err
+	}
+	return int(x.Int64()), nil
+}
+
+// The symbol of a token, or "" if it has none
+func Symbol(client *utils.Client, token []byte) string {
+	symbol, _ := stringCall(client, token, symbolMethod)
+	return symbol
+}
+
+func BalanceOf(client *utils.Client, token, owner []byte) (*big.Int, error) {
+	return uintCall(client, token, balanceOfMethod, utils.HexString(owner))
+}
+
+// How much of owner's tokens spender may still send
+func Allowance(client *utils.Client, token, owner, spender []byte) (*big.Int, error) {
+	return uintCall(client, token, allowanceMethod, utils.HexString(owner), utils.HexString(spender))
+}
+
+// Parse an amount of tokens, eg. "1.5", scaled by the token's decimals
+func ParseAmount(client *utils.Client, token []byte, s string) (*big.Int, error) {
+	decimals, err := Decimals(client, token)
+	if err != nil {
+		return nil, err
+	}
+	return utils.ParseDecimal(s, decimals)
+}
+
+// render an amount of tokens, with the symbol if there is one
+func Format(amount *big.Int, decimals int, symbol string) string {
+	s := utils.FormatUnits(amount, decimals)
+	if symbol != "" {
+		s += " " + symbol
+	}
+	return s
+}
+
+func uintCall(client *utils.Client, token []byte, m *abi.Method, args ...string) (*big.Int, error) {
+	values, err := call(client, token, m, args...)
+	if err != nil {
+		return nil, err
+	}
+	s, _ := values[0].Value.(string)
+	x, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("%s returned %v, which isn't a number", m.Name, values[0].Value)
+	}
+	return x, nil
+}
+
+// strings may be bytes32, padded with zeros
+func stringCall(client *utils.Client, token []byte, m *abi.Method) (string, error) {
+	ret, err := rawCall(client, token, m)
+	if err != nil {
+		return "", err
+	}
+	if values, err := m.Unpack(ret); err == nil {
+		return values[0].Value.(string), nil
+	}
+	if len(ret) == 32 {
+		return strings.TrimRight(string(ret), "\x00"), nil
+	}
+	return "", fmt.Errorf("%s returned 0x%x, which isn't a string", m.Name, ret)
+}
+
+func call(client *utils.Client, token []byte, m *abi.Method, args ...string) ([]abi.Value, error) {
+	ret, err := rawCall(client, token, m, args...)
+	if err != nil {
+		return nil, err
+	}
+	return m.Unpack(ret)
+}
+
+// call a method of the token, which must return something
+func rawCall(client *utils.Client, token []byte, m *abi.Method, args ...string) ([]byte, error) {
+	ret, err := abi.CallRaw(client, token, m, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("%s has no %s() (is it an ERC-20 token?)", utils.ChecksumAddress(token), m.Name)
+	}
+	return ret, nil
+}
@@ -0,0 +1,71 @@
+package token
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eris-ltd/eth-client/utils"
+)
+
+func TestCheckReturn(t *testing.T) {
+	for _, test := range []struct {
+		ret string
+		ok  bool
+	}{
+		{"", true}, // tokens that return nothing, like USDT
+		{"0000000000000000000000000000000000000000000000000000000000000001", true},
+		{"0000000000000000000000000000000000000000000000000000000000000000", false},
+		{"0000000000000000000000000000000000000000000000000000000000000002", false},
+		{"01", false},
+		{"000000000000000000000000000000000000000000000000000000000000000100", true}, // trailing bytes are ignored
+	} {
+		ret, _ := hex.DecodeString(test.ret)
+		if err := CheckReturn(ret); (err == nil) != test.ok {
+			t.Errorf("0x%s: got error %v, want ok %v", test.ret, err, test.ok)
+		}
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	// a token whose decimals() returns *decimals, or nothing if it's negative
+	var decimals int
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if decimals < 0 {
+			fmt.Fprint(w, `{"jsonrpc": "2.0", "id": "", "result": "0x"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc": "2.0", "id": "", "result": "0x%064x"}`, decimals)
+	}))
+	defer node.Close()
+	client := utils.NewClient(node.URL)
+	token, _ := hex.DecodeString("000000000000000000000000000000000000c0de")
+
+	for _, test := range []struct {
+		decimals int
+		s, x     string // x "" means an error
+	}{
+		{6, "1.5", "1500000"},
+		{6, "0.000001", "1"},
+		{6, "0.0000001", ""}, // too precise
+		{6, "0x16e360", "1500000"},
+		{18, "1", "1000000000000000000"},
+		{18, "1e-18", "1"},
+		{0, "7", "7"},
+		{0, "1.5", ""},
+		{6, "-1", ""},
+		{-1, "1", ""}, // no decimals()
+	} {
+		decimals = test.decimals
+		x, err := ParseAmount(client, token, test.s)
+		if test.x == "" {
+			if err == nil {
+				t.Errorf("%s with %d decimals: parsed as %v", test.s, test.decimals, x)
+			}
+		} else if err != nil || x.String() != test.x {
+			t.Errorf("%s with %d decimals: got %v (%v), want %s", test.s, test.decimals, x, err, test.x)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/crypto"
+)
+
+//---------------------------------------------------------------
+// addresses
+//
+// Addresses must be exactly 20 bytes, so a typo can't be padded
+// or truncated into a valid looking one. Mixed case addresses carry an
+// EIP-55 checksum, which must match; all lower or upper case ones have none.
+
+// Accept mixed case addresses with bad checksums (eg. --skip-checksum)
+var SkipChecksum bool
+
+// Parse a hex address (with or without 0x)
+func ParseAddress(s string) ([]byte, error) {
+	h := StripHex(s)
+	if len(h) != 40 {
+		return nil, fmt.Errorf("address %s is %d hex chars, must be 40 (20 bytes)", s, len(h))
+	}
+	addr, err := hex.DecodeString(h)
+	if err != nil {
+		return nil, fmt.Errorf("address %s is bad hex: %v", s, err)
+	}
+	if h != strings.ToLower(h) && h != strings.ToUpper(h) && !SkipChecksum {
+		if sum := ChecksumAddress(addr); sum[2:] != h {
+			return nil, fmt.Errorf("address %s has a bad checksum, expected %s (is there a typo? use --skip-checksum to ignore)", s, sum)
+		}
+	}
+	return addr, nil
+}
+
+// The EIP-55 checksummed hex of an address: hex digits are upper cased
+// where the matching nibble of the keccak256 of the lower case hex is >= 8
+func ChecksumAddress(addr []byte) string {
+	h := []byte(hex.EncodeToString(addr))
+	hash := crypto.Keccak256(h)
+	for i, c := range h {
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0xf
+		}
+		if c >= 'a' && nibble >= 8 {
+			h[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(h)
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+// the examples from EIP-55
+var checksumTests = []string{
+	// all caps
+	"0x52908400098527886E0F7030069857D2E4169EE7",
+	"0x8617E340B3D01FA5F11F306F4090FD50E238070D",
+	// all lower
+	"0xde709f2102306220921060314715629080e2fb77",
+	"0x27b1fdb04752bbc536007a920d24acb045561c26",
+	// normal
+	"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+	"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+	"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+	"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+}
+
+func TestChecksumAddress(t *testing.T) {
+	for _, want := range checksumTests {
+		addr, err := ParseAddress(want)
+		if err != nil {
+			t.Errorf("%s: %v", want, err)
+			continue
+		}
+		if got := ChecksumAddress(addr); got != want {
+			t.Errorf("checksum of %s is %s", want, got)
+		}
+	}
+}
+
+func TestParseAddress(t *testing.T) {
+	for _, test := range []struct {
+		addr string
+		ok   bool
+	}{
+		{"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true},
+		{"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", true},
+		{"0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", true},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", false}, // bad checksum
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", false},   // short
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed00", false},
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg", false},
+	} {
+		if _, err := ParseAddress(test.addr); (err == nil) != test.ok {
+			t.Errorf("%s: got error %v, want ok %v", test.addr, err, test.ok)
+		}
+	}
+
+	SkipChecksum = true
+	defer func() { SkipChecksum = false }()
+	if _, err := ParseAddress(strings.Replace(checksumTests[4], "aA", "Aa", 1)); err != nil {
+		t.Errorf("bad checksum not skipped: %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -59,17 +60,52 @@ func (c *Client) requestResponse(s *shared.Request) (b []byte, err error) {
 	return ioutil.ReadAll(resp.Body)
 }
 
+// An error returned by the node. Data is the error's data field,
+// if it has one (eg. the revert data of a failed eth_call)
+type RPCError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("error code %d: %s", e.Code, e.Message)
+}
+
+// The error data as bytes, if it's hex.
+// Some nodes nest it in an object under "data"
+func (e *RPCError) DataBytes() ([]byte, bool) {
+	d := e.Data
+	if m, ok := d.(map[string]interface{}); ok {
+		d = m["data"]
+	}
+	s, ok := d.(string)
+	if !ok || !strings.HasPrefix(s, "0x") {
+		return nil, false
+	}
+	b, err := hex.DecodeString(StripHex(s))
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
+
+type errorResponse struct {
+	Error *RPCError `json:"error"`
+}
+
+// node errors are returned as *RPCError
 func unmarshalCheckError(body []byte) (interface{}, error) {
-	var errResponse shared.ErrorResponse
+	var errResponse errorResponse
 	var successResponse shared.SuccessResponse
 	if err := json.Unmarshal(body, &errResponse); err == nil {
 		if errResponse.Error != nil {
-			return nil, fmt.Errorf("error code %d: %s", errResponse.Error.Code, errResponse.Error.Message)
+			return nil, errResponse.Error
 		}
 	}
 
 	if err := json.Unmarshal(body, &successResponse); err != nil {
-		return nil, fmt.Errorf("error unmarshaling success response", err)
+		return nil, fmt.Errorf("error unmarshaling success response: %v", err)
 	}
 	return successResponse.Result, nil
 }
@@ -88,3 +124,8 @@ func StripHex(s string) string {
 	}
 	return s
 }
+
+// Hex with the 0x prefix, as the rpc takes it
+func HexString(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
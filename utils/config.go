@@ -0,0 +1,252 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/BurntSushi/toml"
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/eris-ltd/common/go/common"
+	"github.com/eris-ltd/eth-client/Godeps/_workspace/src/github.com/spf13/pflag"
+)
+
+//---------------------------------------------------------------
+// config file and profiles
+//
+// The config file holds named profiles, each with the settings for working
+// with a chain: its node, the signer, the default address, chain id, gas
+// policy, and an address book of names for addresses. A profile only fills
+// in what isn't given otherwise: a flag beats its env var (ETHTX_*), which
+// beats the profile. The profile is chosen with --profile, ETHTX_PROFILE,
+// or the config's own default, and the config file is moved with ETHTX_CONFIG.
+
+var ConfigPath = path.Join(common.Usr(), ".eth-client", "config.toml")
+
+type Config struct {
+	Profile  string              `toml:"profile"` // used if none is chosen
+	Profiles map[string]*Profile `toml:"profiles"`
+}
+
+type Profile struct {
+	Node        string            `toml:"node"` // host:port
+	ChainID     string            `toml:"chain-id"`
+	From        string            `toml:"from"`
+	Registrar   string            `toml:"registrar"`
+	ENSRegistry string            `toml:"ens-registry"`
+	Signer      SignerProfile     `toml:"signer"`
+	Gas         GasProfile        `toml:"gas"`
+	Addresses   map[string]string `toml:"addresses"`
+}
+
+type SignerProfile struct {
+	Backend      string `toml:"backend"` // eris-keys, keystore or mnemonic (default whichever is set)
+	Addr         string `toml:"addr"`    // of eris-keys
+	Keystore     string `toml:"keystore"`
+	PasswordFile string `toml:"password-file"`
+	MnemonicFile string `toml:"mnemonic-file"`
+	HDPath       string `toml:"hd-path"`
+}
+
+type GasProfile struct {
+	Type          string  `toml:"type"`
+	Price         string  `toml:"price"`
+	MaxFee        string  `toml:"max-fee"`
+	PriorityFee   string  `toml:"priority-fee"`
+	MaxPrice      string  `toml:"max-price"`
+	MaxGas        string  `toml:"max-gas"`
+	Multiplier    float64 `toml:"multiplier"`
+	FeePercentile float64 `toml:"fee-percentile"`
+}
+
+// Names for addresses, from the profile's address book, taken
+// anywhere an address is (see LookupAddress)
+var AddressBook map[string]string
+
+// The address of a name in the address book
+func LookupAddress(name string) (string, bool) {
+	addr, ok := AddressBook[name]
+	return addr, ok
+}
+
+func LoadConfig(configPath string) (*Config, error) {
+	cfg := new(Config)
+	meta, err := toml.DecodeFile(configPath, cfg)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %v", configPath, err)
+	}
+	// catch misspelt settings rather than quietly ignoring them
+	if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+		var keys []string
+		for _, key := range undecoded {
+			keys = append(keys, key.String())
+		}
+		sort.Strings(keys)
+		return nil, fmt.Errorf("%s: unknown settings %s", configPath, strings.Join(keys, ", "))
+	}
+	for name, p := range cfg.Profiles {
+		if err := p.check(); err != nil {
+			return nil, fmt.Errorf("%s: profile %s: %v", configPath, name, err)
+		}
+	}
+	return cfg, nil
+}
+
+// Load the profile with the name (or, if it's empty, the one named by ETHTX_PROFILE
+// or the config's default). Without a config file or a default, there's no profile (nil)
+func LoadProfile(name string) (*Profile, error) {
+	if name == "" {
+		name = os.Getenv("ETHTX_PROFILE")
+	}
+	configPath := ConfigPath
+	if env := os.Getenv("ETHTX_CONFIG"); env != "" {
+		configPath = env
+	}
+	cfg, err := LoadConfig(configPath)
+	if os.IsNotExist(err) {
+		if name != "" {
+			return nil, fmt.Errorf("no config file %s for profile %s", configPath, name)
+		}
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		if name = cfg.Profile; name == "" {
+			return nil, nil
+		}
+	}
+	p, ok := cfg.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("no profile %s in %s", name, configPath)
+	}
+	return p, nil
+}
+
+func (p *Profile) check() error {
+	s := &p.Signer
+	if s.Backend == "" {
+		switch {
+		case s.MnemonicFile != "":
+			s.Backend = "mnemonic"
+		case s.Keystore != "":
+			s.Backend = "keystore"
+		default:
+			s.Backend = "eris-keys"
+		}
+	}
+	switch s.Backend {
+	case "eris-keys":
+	case "keystore":
+		if s.Keystore == "" {
+			return fmt.Errorf("the keystore signer needs a keystore directory")
+		}
+	case "mnemonic":
+		if s.MnemonicFile == "" {
+			return fmt.Errorf("the mnemonic signer needs a mnemonic-file")
+		}
+	default:
+		return fmt.Errorf("unknown signer backend %s (must be eris-keys, keystore or mnemonic)", s.Backend)
+	}
+	s.Keystore, s.PasswordFile, s.MnemonicFile = expandHome(s.Keystore), expandHome(s.PasswordFile), expandHome(s.MnemonicFile)
+	return nil
+}
+
+// a profile setting, the flags it's for, and the env var that beats it
+type setting struct {
+	flags []string
+	env   string
+	value string
+}
+
+func (p *Profile) settings() []setting {
+	settings := []setting{
+		{[]string{"node-addr"}, "ETHTX_NODE_ADDR", strings.TrimPrefix(p.Node, "http://")},
+		{[]string{"chain-id"}, "", p.ChainID},
+		{[]string{"addr"}, "ETHTX_ADDR", p.From},
+		{[]string{"registrar"}, "ETHTX_REGISTRAR", p.Registrar},
+		{[]string{"ens-registry"}, "ETHTX_ENS_REGISTRY", p.ENSRegistry},
+		{[]string{"type"}, "", p.Gas.Type},
+		{[]string{"price"}, "", p.Gas.Price},
+		{[]string{"max-fee"}, "", p.Gas.MaxFee},
+		{[]string{"priority-fee"}, "", p.Gas.PriorityFee},
+		{[]string{"max-price"}, "", p.Gas.MaxPrice},
+		{[]string{"max-gas"}, "", p.Gas.MaxGas},
+		{[]string{"gas-multiplier"}, "", formatFloat(p.Gas.Multiplier)},
+		{[]string{"fee-percentile"}, "", formatFloat(p.Gas.FeePercentile)},
+	}
+	s := p.Signer
+	switch s.Backend {
+	case "eris-keys":
+		settings = append(settings, setting{[]string{"sign-addr"}, "ETHTX_SIGN_ADDR", s.Addr})
+	case "keystore":
+		settings = append(settings,
+			setting{[]string{"keystore"}, "", s.Keystore},
+			setting{[]string{"password-file"}, "", s.PasswordFile})
+	case "mnemonic":
+		settings = append(settings,
+			setting{[]string{"mnemonic-file", "mnemonic"}, "", s.MnemonicFile},
+			setting{[]string{"hd-path"}, "", s.HDPath})
+	}
+	return settings
+}
+
+// the flags that choose a signer: if any is given, the profile's signer isn't used.
+// Those that only modify it (--hd-path, --password-file) don't stop it being used
+var signerFlags = []string{"sign-addr", "keystore", "mnemonic-file", "mnemonic"}
+
+// Fill in the flags that weren't given, and whose env var isn't set, from the
+// profile, except for those skipped. It also sets the AddressBook
+func (p *Profile) Apply(flags *pflag.FlagSet, skip ...string) error {
+	for _, name := range signerFlags {
+		if f := flags.Lookup(name); f != nil && f.Changed {
+			skip = append(skip, signerFlags...)
+			skip = append(skip, "password-file", "hd-path")
+			break
+		}
+	}
+	for _, s := range p.settings() {
+		if s.value == "" || (s.env != "" && os.Getenv(s.env) != "") {
+			continue
+		}
+		for _, name := range s.flags {
+			f := flags.Lookup(name)
+			if f == nil || f.Changed || contains(skip, name) {
+				continue
+			}
+			if err := flags.Set(name, s.value); err != nil {
+				return fmt.Errorf("bad %s %q in profile: %v", name, s.value, err)
+			}
+		}
+	}
+	AddressBook = p.Addresses
+	return nil
+}
+
+func formatFloat(x float64) string {
+	if x == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+func expandHome(p string) string {
+	if strings.HasPrefix(p, "~/") {
+		return path.Join(common.Usr(), p[2:])
+	}
+	return p
+}
+
+func contains(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// decimals of the common ether denominations
+const (
+	WeiDecimals   = 0
+	GweiDecimals  = 9
+	EtherDecimals = 18
+)
+
+// the denominations accepted in amounts, eg. "1.5ether" or "20gwei"
+var units = []struct {
+	name     string
+	decimals int
+}{
+	// a unit comes before the shorter ones it ends with (gwei before wei,
+	// ether before eth), so suffixes match the right unit
+	{"finney", 15},
+	{"szabo", 12},
+	{"ether", EtherDecimals},
+	{"gwei", GweiDecimals},
+	{"mwei", 6},
+	{"kwei", 3},
+	{"wei", WeiDecimals},
+	{"eth", EtherDecimals},
+}
+
+// The number of decimals of a denomination (eg. 18 for "ether")
+func UnitDecimals(unit string) (int, error) {
+	unit = strings.ToLower(strings.TrimSpace(unit))
+	for _, u := range units {
+		if u.name == unit {
+			return u.decimals, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown unit %s (must be wei, kwei, mwei, gwei, szabo, finney or ether)", unit)
+}
+
+// Parse an amount of wei, given as an integer ("100", "0x64"), with a unit
+// ("1.5ether", "20 gwei") or in scientific notation ("1e18", "2.5e3gwei").
+// The parsing is exact: amounts that aren't a whole number of wei are an error, never rounded
+func ParseUnits(s string) (*big.Int, error) {
+	num := strings.ToLower(strings.TrimSpace(s))
+	if num == "" {
+		return nil, fmt.Errorf("empty amount")
+	}
+	if strings.HasPrefix(num, "0x") {
+		return parseHex(num, s)
+	}
+
+	decimals := WeiDecimals
+	for _, u := range units {
+		if strings.HasSuffix(num, u.name) {
+			num, decimals = strings.TrimSpace(strings.TrimSuffix(num, u.name)), u.decimals
+			break
+		}
+	}
+	r, err := scale(num, decimals, s)
+	if err != nil {
+		return nil, err
+	}
+	if !r.IsInt() {
+		return nil, fmt.Errorf("%s is not a whole number of wei", s)
+	}
+	return new(big.Int).Set(r.Num()), nil
+}
+
+// Parse an amount of something with the given number of decimals (eg. a token),
+// so ParseDecimal("1.5", 6) = 1500000. Hex is taken as is, in the smallest unit.
+// As with ParseUnits, the parsing is exact
+func ParseDecimal(s string, decimals int) (*big.Int, error) {
+	num := strings.ToLower(strings.TrimSpace(s))
+	if num == "" {
+		return nil, fmt.Errorf("empty amount")
+	}
+	if strings.HasPrefix(num, "0x") {
+		return parseHex(num, s)
+	}
+	r, err := scale(num, decimals, s)
+	if err != nil {
+		return nil, err
+	}
+	if !r.IsInt() {
+		return nil, fmt.Errorf("%s has more than %d decimals", s, decimals)
+	}
+	return new(big.Int).Set(r.Num()), nil
+}
+
+func parseHex(num, s string) (*big.Int, error) {
+	x, ok := new(big.Int).SetString(num[2:], 16)
+	if !ok || strings.HasPrefix(num[2:], "-") || strings.HasPrefix(num[2:], "+") {
+		return nil, fmt.Errorf("%s is bad hex", s)
+	}
+	return x, nil
+}
+
+// a decimal number (s is the whole amount, for errors) times 10^decimals
+func scale(num string, decimals int, s string) (*big.Rat, error) {
+	// big.Rat also takes fractions like 1/3, which aren't amounts
+	if num == "" || strings.ContainsAny(num, "/_") {
+		return nil, fmt.Errorf("%s is not a number", s)
+	}
+	r, ok := new(big.Rat).SetString(num)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a number", s)
+	}
+	if r.Sign() < 0 {
+		return nil, fmt.Errorf("%s is negative", s)
+	}
+	return r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))), nil
+}
+
+// Render an integer amount with the given number of decimals,
+// eg. FormatUnits(1500000000000000000, 18) = "1.5".
+// The result is exact: trailing zeros are trimmed, never digits
+func FormatUnits(x *big.Int, decimals int) string {
+	neg := x.Sign() < 0
+	digits := new(big.Int).Abs(x).String()
+	if decimals > 0 {
+		if len(digits) <= decimals {
+			digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+		}
+		whole, frac := digits[:len(digits)-decimals], strings.TrimRight(digits[len(digits)-decimals:], "0")
+		digits = whole
+		if frac != "" {
+			digits += "." + frac
+		}
+	}
+	if neg {
+		digits = "-" + digits
+	}
+	return digits
+}
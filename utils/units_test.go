@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseUnits(t *testing.T) {
+	for _, test := range []struct {
+		s, wei string // wei "" means an error
+	}{
+		{"100", "100"},
+		{"0x64", "100"},
+		{"0X64", "100"},
+		{"1.5ether", "1500000000000000000"},
+		{"1.5 ETH", "1500000000000000000"},
+		{"20 gwei", "20000000000"},
+		{"2.5e3gwei", "2500000000000"},
+		{"1e18", "1000000000000000000"},
+		{"5finney", "5000000000000000"},
+		{"1wei", "1"},
+		{"0.000000000000000001ether", "1"},
+		{"10000000000000000000", "10000000000000000000"}, // more than 2^63
+		{"100000000ether", "100000000000000000000000000"},
+		{"0xffffffffffffffffffffffffffffffff", "340282366920938463463374607431768211455"},
+		{"1e-19ether", ""},
+		{"1.0000000000000000001ether", ""},
+		{"0.5", ""},
+		{"-1", ""},
+		{"-1ether", ""},
+		{"0x-1", ""},
+		{"1/3", ""},
+		{"1/3ether", ""},
+		{"1_000", ""},
+		{"0xzz", ""},
+		{"", ""},
+		{"ether", ""},
+		{"1.5 bitcoin", ""},
+	} {
+		x, err := ParseUnits(test.s)
+		if test.wei == "" {
+			if err == nil {
+				t.Errorf("%q: parsed as %v", test.s, x)
+			}
+		} else if err != nil || x.String() != test.wei {
+			t.Errorf("%q: got %v (%v), want %s", test.s, x, err, test.wei)
+		}
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	for _, test := range []struct {
+		s        string
+		decimals int
+		x        string // "" means an error
+	}{
+		{"1.5", 6, "1500000"},
+		{"0.000001", 6, "1"},
+		{"0x10", 6, "16"}, // hex is in the smallest unit
+		{"1", 0, "1"},
+		{"0.0000001", 6, ""},
+		{"1.5", 0, ""},
+		{"-1", 6, ""},
+	} {
+		x, err := ParseDecimal(test.s, test.decimals)
+		if test.x == "" {
+			if err == nil {
+				t.Errorf("%q (%d decimals): parsed as %v", test.s, test.decimals, x)
+			}
+		} else if err != nil || x.String() != test.x {
+			t.Errorf("%q (%d decimals): got %v (%v), want %s", test.s, test.decimals, x, err, test.x)
+		}
+	}
+}
+
+func TestFormatUnits(t *testing.T) {
+	for _, test := range []struct {
+		x        string
+		decimals int
+		s        string
+	}{
+		{"1500000000000000000", EtherDecimals, "1.5"},
+		{"1000000000000000000", EtherDecimals, "1"},
+		{"1", EtherDecimals, "0.000000000000000001"},
+		{"0", EtherDecimals, "0"},
+		{"20000000000", GweiDecimals, "20"},
+		{"123456789012345678901234567890", EtherDecimals, "123456789012.34567890123456789"},
+		{"100", WeiDecimals, "100"},
+		{"-15", 1, "-1.5"},
+	} {
+		x, _ := new(big.Int).SetString(test.x, 10)
+		s := FormatUnits(x, test.decimals)
+		if s != test.s {
+			t.Errorf("%s (%d decimals): formatted as %s, want %s", test.x, test.decimals, s, test.s)
+		}
+		// and back
+		if x.Sign() < 0 {
+			continue
+		}
+		if y, err := ParseDecimal(s, test.decimals); err != nil || y.Cmp(x) != 0 {
+			t.Errorf("%s (%d decimals): parsed back as %v (%v)", s, test.decimals, y, err)
+		}
+	}
+}